@@ -0,0 +1,113 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// ErrAccountSuspended is returned by Account.Get when the account is suspended or blocked
+var ErrAccountSuspended = errors.New("account is suspended")
+
+type account struct {
+	Client *client
+}
+
+// AccountLimits are the limits of the account plan
+type AccountLimits struct {
+	EmailsPerMonth int
+	Subscribers    int
+	SMS            int
+	Push           int
+}
+
+// AccountUsage is the part of the plan limits used in the current period
+type AccountUsage struct {
+	EmailsSent  int
+	Subscribers int
+}
+
+// AccountInfo is the account with its plan. Expires is zero for plans that don't expire
+type AccountInfo struct {
+	Email      string
+	Name       string
+	TariffName string
+	Expires    time.Time
+	Limits     AccountLimits
+	Usage      AccountUsage
+}
+
+type accountInfoRaw struct {
+	Email  string      `json:"email"`
+	Name   string      `json:"name"`
+	Status interface{} `json:"status"`
+	Tariff struct {
+		Name    string `json:"name"`
+		Expires string `json:"expires"`
+		Limits  struct {
+			EmailsPerMonth interface{} `json:"emails_per_month"`
+			Subscribers    interface{} `json:"subscribers"`
+			SMS            interface{} `json:"sms"`
+			Push           interface{} `json:"push"`
+		} `json:"limits"`
+	} `json:"tariff"`
+	Usage struct {
+		EmailsSent  interface{} `json:"emails_sent"`
+		Subscribers interface{} `json:"subscribers"`
+	} `json:"usage"`
+}
+
+// suspended reports whether the account status is one of the states in which sending is disabled
+func (raw accountInfoRaw) suspended() bool {
+	switch strings.ToLower(toString(raw.Status)) {
+	case "suspended", "blocked", "banned":
+		return true
+	}
+	return false
+}
+
+// Get returns the account info with its plan name, limits, expiry date and current usage
+func (a *account) Get() (*AccountInfo, error) {
+	return a.GetContext(context.Background())
+}
+
+func (a *account) GetContext(ctx context.Context) (*AccountInfo, error) {
+	path := "/user/fullinfo"
+
+	body, err := a.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw accountInfoRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, a.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	if raw.suspended() {
+		return nil, ErrAccountSuspended
+	}
+
+	info := AccountInfo{
+		Email:      raw.Email,
+		Name:       raw.Name,
+		TariffName: raw.Tariff.Name,
+		Limits: AccountLimits{
+			EmailsPerMonth: toInt(raw.Tariff.Limits.EmailsPerMonth),
+			Subscribers:    toInt(raw.Tariff.Limits.Subscribers),
+			SMS:            toInt(raw.Tariff.Limits.SMS),
+			Push:           toInt(raw.Tariff.Limits.Push),
+		},
+		Usage: AccountUsage{
+			EmailsSent:  toInt(raw.Usage.EmailsSent),
+			Subscribers: toInt(raw.Usage.Subscribers),
+		},
+	}
+	if expires, err := time.Parse(sendDateLayout, raw.Tariff.Expires); err == nil {
+		info.Expires = expires
+	}
+
+	return &info, nil
+}
@@ -0,0 +1,57 @@
+package sendpulse
+
+import (
+	"context"
+	"time"
+)
+
+const accountStatsPageSize = 100
+
+// AccountEmailStats are the statistics summed over the email campaigns sent in a date range
+type AccountEmailStats struct {
+	Campaigns int
+	CampaignStats
+}
+
+// EmailStats returns the statistics of all email campaigns sent in the date range, bounds included.
+// A zero date leaves its side of the range open.
+// SendPulse has no account-wide endpoint, so every campaign is listed (a request per 100 campaigns)
+// and then requested for its send date and statistics (a request per campaign)
+func (a *account) EmailStats(dateFrom time.Time, dateTo time.Time) (*AccountEmailStats, error) {
+	return a.EmailStatsContext(context.Background(), dateFrom, dateTo)
+}
+
+func (a *account) EmailStatsContext(ctx context.Context, dateFrom time.Time, dateTo time.Time) (*AccountEmailStats, error) {
+	if !dateFrom.IsZero() && !dateTo.IsZero() && dateFrom.After(dateTo) {
+		return nil, ErrInvalidDateRange
+	}
+
+	c := &campaigns{Client: a.Client}
+	stats := AccountEmailStats{}
+	for offset := 0; ; offset += accountStatsPageSize {
+		list, err := c.ListContext(ctx, accountStatsPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, campaign := range list {
+			info, err := c.GetContext(ctx, campaign.ID)
+			if err != nil {
+				return nil, err
+			}
+			if info.SendDate.IsZero() ||
+				(!dateFrom.IsZero() && info.SendDate.Before(dateFrom)) ||
+				(!dateTo.IsZero() && info.SendDate.After(dateTo)) {
+				continue
+			}
+			stats.Campaigns++
+			stats.CampaignStats.add(info.Statistics)
+		}
+
+		if len(list) < accountStatsPageSize {
+			break
+		}
+	}
+
+	return &stats, nil
+}
@@ -0,0 +1,70 @@
+package sendpulse
+
+import (
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAccount_EmailStats(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/campaigns?limit=100&offset=0", apiBaseUrl),
+		httpmock.NewStringResponder(http.StatusOK, `[{"id": 1, "name": "May"}, {"id": 2, "name": "June"}, {"id": 3, "name": "Old"}]`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/1",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 1, "send_date": "2021-05-10 10:00:00", "statistics": [
+			{"code": 1, "count": 100, "explain": "Sent"},
+			{"code": 2, "count": 95, "explain": "Delivered"},
+			{"code": 3, "count": 40, "explain": "Opened"},
+			{"code": 4, "count": 10, "explain": "Redirected"},
+			{"code": 5, "count": 2, "explain": "Unsubscribed"},
+			{"code": 6, "count": 5, "explain": "Undelivered"}
+		]}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/2",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 2, "send_date": "2021-06-01 09:30:00", "statistics": [
+			{"code": 1, "count": "50", "explain": "Sent"},
+			{"code": 2, "count": 48, "explain": "Delivered"},
+			{"code": 3, "count": 20, "explain": "Opened"},
+			{"code": 6, "count": 2, "explain": "Undelivered"}
+		]}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/3",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 3, "send_date": "2020-01-01 00:00:00", "statistics": [
+			{"code": 1, "count": 1000, "explain": "Sent"}
+		]}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	stats, err := spClient.Account.EmailStats(
+		time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, &AccountEmailStats{
+		Campaigns: 2,
+		CampaignStats: CampaignStats{
+			Sent:         150,
+			Delivered:    143,
+			Opened:       60,
+			Clicked:      10,
+			Bounced:      7,
+			Unsubscribed: 2,
+		},
+	}, stats)
+}
+
+func TestAccount_EmailStats_InvalidDateRange(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Account.EmailStats(time.Now(), time.Now().Add(-time.Hour))
+	assert.Equal(t, ErrInvalidDateRange, err)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,76 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAccount_Get(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/user/fullinfo",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"email": "owner@example.com",
+			"name": "John Doe",
+			"status": "active",
+			"tariff": {
+				"name": "Standard 2500",
+				"expires": "2026-12-01 00:00:00",
+				"limits": {"emails_per_month": 15000, "subscribers": "2500", "sms": 0, "push": 10000}
+			},
+			"usage": {"emails_sent": 1200, "subscribers": 310}
+		}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	info, err := spClient.Account.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, AccountInfo{
+		Email:      "owner@example.com",
+		Name:       "John Doe",
+		TariffName: "Standard 2500",
+		Expires:    time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC),
+		Limits:     AccountLimits{EmailsPerMonth: 15000, Subscribers: 2500, SMS: 0, Push: 10000},
+		Usage:      AccountUsage{EmailsSent: 1200, Subscribers: 310},
+	}, *info)
+}
+
+func TestAccount_Get_Suspended(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/user/fullinfo",
+		httpmock.NewStringResponder(http.StatusOK, `{"email": "owner@example.com", "status": "suspended", "tariff": {"name": "Free"}}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	info, err := spClient.Account.Get()
+	assert.Nil(t, info)
+	assert.Equal(t, ErrAccountSuspended, err)
+}
+
+func TestAccount_Get_BadJson(t *testing.T) {
+	path := "/user/fullinfo"
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+path,
+		httpmock.NewStringResponder(http.StatusOK, `Invalid json`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	info, err := spClient.Account.Get()
+	assert.Nil(t, info)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, apiBaseUrl+path, spErr.Url)
+}
@@ -0,0 +1,120 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type balance struct {
+	Client *client
+}
+
+type Balance struct {
+	Currency     string
+	BalanceMain  float64
+	BalanceBonus float64
+}
+
+// DetailedBalance is the account balance together with the credits left for every service
+type DetailedBalance struct {
+	Balance Balance
+	Email   ServiceCredits
+	SMTP    ServiceCredits
+	Push    ServiceCredits
+	SMS     ServiceCredits
+}
+
+type ServiceCredits struct {
+	TariffName string
+	Left       int
+}
+
+type balanceRaw struct {
+	Currency     string      `json:"currency"`
+	BalanceMain  interface{} `json:"balance_main"`
+	BalanceBonus interface{} `json:"balance_bonus"`
+}
+
+type detailedBalanceRaw struct {
+	Balance struct {
+		Main     interface{} `json:"main"`
+		Bonus    interface{} `json:"bonus"`
+		Currency string      `json:"currency"`
+	} `json:"balance"`
+	Email struct {
+		TariffName string      `json:"tariff_name"`
+		EmailsLeft interface{} `json:"emails_left"`
+	} `json:"email"`
+	SMTP struct {
+		TariffName string      `json:"tariff_name"`
+		EmailsLeft interface{} `json:"emails_left"`
+	} `json:"smtp"`
+	Push struct {
+		TariffName string      `json:"tariff_name"`
+		Left       interface{} `json:"left"`
+	} `json:"push"`
+	SMS struct {
+		TariffName string      `json:"tariff_name"`
+		Left       interface{} `json:"left"`
+	} `json:"sms"`
+}
+
+// Get returns the balance in the given currency (e.g. "USD"). An empty currency means the account currency
+func (b *balance) Get(currency string) (*Balance, error) {
+	return b.GetContext(context.Background(), currency)
+}
+
+func (b *balance) GetContext(ctx context.Context, currency string) (*Balance, error) {
+	path := "/balance"
+	if currency != "" {
+		path = fmt.Sprintf("/balance/%s", strings.ToUpper(currency))
+	}
+
+	body, err := b.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw balanceRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, b.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	return &Balance{
+		Currency:     raw.Currency,
+		BalanceMain:  toFloat(raw.BalanceMain),
+		BalanceBonus: toFloat(raw.BalanceBonus),
+	}, nil
+}
+
+func (b *balance) GetDetailed() (*DetailedBalance, error) {
+	return b.GetDetailedContext(context.Background())
+}
+
+func (b *balance) GetDetailedContext(ctx context.Context) (*DetailedBalance, error) {
+	path := "/user/balance/detail"
+
+	body, err := b.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw detailedBalanceRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, b.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	return &DetailedBalance{
+		Balance: Balance{
+			Currency:     raw.Balance.Currency,
+			BalanceMain:  toFloat(raw.Balance.Main),
+			BalanceBonus: toFloat(raw.Balance.Bonus),
+		},
+		Email: ServiceCredits{TariffName: raw.Email.TariffName, Left: toInt(raw.Email.EmailsLeft)},
+		SMTP:  ServiceCredits{TariffName: raw.SMTP.TariffName, Left: toInt(raw.SMTP.EmailsLeft)},
+		Push:  ServiceCredits{TariffName: raw.Push.TariffName, Left: toInt(raw.Push.Left)},
+		SMS:   ServiceCredits{TariffName: raw.SMS.TariffName, Left: toInt(raw.SMS.Left)},
+	}, nil
+}
@@ -0,0 +1,62 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestBalance_GetDetailed_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/user/balance/detail",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"balance": {"main": "20.00", "bonus": 5, "currency": "USD"},
+			"email": {"tariff_name": "Standard", "emails_left": "4500"},
+			"smtp": {"tariff_name": "Free", "emails_left": 12000},
+			"push": {"tariff_name": "Free", "left": 100},
+			"sms": {"tariff_name": "", "left": "0"}
+		}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	b, err := spClient.Balance.GetDetailed()
+	assert.NoError(t, err)
+	assert.Equal(t, DetailedBalance{
+		Balance: Balance{Currency: "USD", BalanceMain: 20, BalanceBonus: 5},
+		Email:   ServiceCredits{TariffName: "Standard", Left: 4500},
+		SMTP:    ServiceCredits{TariffName: "Free", Left: 12000},
+		Push:    ServiceCredits{TariffName: "Free", Left: 100},
+		SMS:     ServiceCredits{TariffName: "", Left: 0},
+	}, *b)
+}
+
+func TestBalance_GetDetailed_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/user/balance/detail",
+		httpmock.NewStringResponder(http.StatusBadRequest, ``))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	b, err := spClient.Balance.GetDetailed()
+	assert.Nil(t, b)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
@@ -0,0 +1,70 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestBalance_Get_StringNumbers(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/balance/USD",
+		httpmock.NewStringResponder(http.StatusOK, `{"currency": "USD", "balance_main": "12.50", "balance_bonus": "0.00"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	b, err := spClient.Balance.Get("usd")
+	assert.NoError(t, err)
+	assert.Equal(t, Balance{Currency: "USD", BalanceMain: 12.5, BalanceBonus: 0}, *b)
+}
+
+func TestBalance_Get_RealNumbers(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/balance",
+		httpmock.NewStringResponder(http.StatusOK, `{"currency": "EUR", "balance_main": 7.25, "balance_bonus": 1}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	b, err := spClient.Balance.Get("")
+	assert.NoError(t, err)
+	assert.Equal(t, Balance{Currency: "EUR", BalanceMain: 7.25, BalanceBonus: 1}, *b)
+}
+
+func TestBalance_Get_BadJson(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/balance",
+		httpmock.NewStringResponder(http.StatusOK, `Invalid json`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	b, err := spClient.Balance.Get("")
+	assert.Nil(t, b)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
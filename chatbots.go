@@ -0,0 +1,370 @@
+package sendpulse
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrBotNotFound is returned when the chatbot doesn't exist or isn't connected to the account
+	ErrBotNotFound = errors.New("chatbot not found")
+	// ErrMessagingWindowClosed is returned when the channel refuses a free-form message because
+	// the contact hasn't interacted with the bot recently (e.g. the 24-hour window of WhatsApp, Messenger and Instagram)
+	ErrMessagingWindowClosed = errors.New("messaging window is closed for the contact")
+	// ErrFlowNotFound is returned when the flow to run doesn't exist
+	ErrFlowNotFound = errors.New("chatbot flow not found")
+)
+
+// chatbot implements the API shared by all the chatbot channels. The endpoints only differ by the channel prefix
+type chatbot struct {
+	Client  *client
+	channel string
+}
+
+type Bot struct {
+	ID          string
+	Name        string
+	Username    string
+	Status      int
+	InboxTotal  int
+	InboxUnread int
+	Created     time.Time
+}
+
+type ChatbotContact struct {
+	ID        string
+	BotID     string
+	Name      string
+	Username  string
+	Status    int
+	Variables map[string]interface{}
+	Created   time.Time
+}
+
+type botRaw struct {
+	ID          string `json:"id"`
+	ChannelData struct {
+		Name     string `json:"name"`
+		Username string `json:"username"`
+	} `json:"channel_data"`
+	Inbox struct {
+		Total  interface{} `json:"total"`
+		Unread interface{} `json:"unread"`
+	} `json:"inbox"`
+	Status    interface{} `json:"status"`
+	CreatedAt string      `json:"created_at"`
+}
+
+type chatbotContactRaw struct {
+	ID          string `json:"id"`
+	BotID       string `json:"bot_id"`
+	ChannelData struct {
+		Name      string `json:"name"`
+		FirstName string `json:"first_name"`
+		LastName  string `json:"last_name"`
+		Username  string `json:"username"`
+	} `json:"channel_data"`
+	Status    interface{}            `json:"status"`
+	Variables map[string]interface{} `json:"variables"`
+	CreatedAt string                 `json:"created_at"`
+}
+
+func (raw chatbotContactRaw) contact() ChatbotContact {
+	name := raw.ChannelData.Name
+	if name == "" {
+		name = strings.TrimSpace(raw.ChannelData.FirstName + " " + raw.ChannelData.LastName)
+	}
+
+	contact := ChatbotContact{
+		ID:        raw.ID,
+		BotID:     raw.BotID,
+		Name:      name,
+		Username:  raw.ChannelData.Username,
+		Status:    toInt(raw.Status),
+		Variables: raw.Variables,
+		Created:   chatbotTime(raw.CreatedAt),
+	}
+	if contact.Variables == nil {
+		contact.Variables = make(map[string]interface{})
+	}
+	return contact
+}
+
+// chatbotTime parses timestamps of the chatbots API, which are RFC 3339 unlike the rest of the API
+func chatbotTime(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// checkSuccess validates the {"success": true} body returned by the chatbots API
+func (c *client) checkSuccess(method string, path string, body []byte) error {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	var respData struct {
+		Success *bool `json:"success"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return c.invalidResponse(method, path, body, err.Error())
+	}
+	if respData.Success == nil || !*respData.Success {
+		return c.invalidResponse(method, path, body, "invalid response")
+	}
+	return nil
+}
+
+// chatbotError maps the rejections callers usually need to handle to ErrBotNotFound, ErrFlowNotFound,
+// ErrChatbotContactNotFound and ErrContactNotInFlow. Other errors are returned as is
+func chatbotError(err error) error {
+	spErr, ok := err.(*SendpulseError)
+	if !ok {
+		return err
+	}
+
+	description := strings.ToLower(spErr.ErrorDescription + " " + spErr.Body)
+	switch {
+	case (spErr.HttpCode == http.StatusBadRequest || spErr.HttpCode == http.StatusNotFound || spErr.HttpCode == http.StatusUnprocessableEntity) &&
+		(strings.Contains(description, "not in flow") || strings.Contains(description, "not in the flow") || strings.Contains(description, "not subscribed")):
+		return ErrContactNotInFlow
+	case spErr.HttpCode == http.StatusNotFound && strings.Contains(description, "flow"):
+		return ErrFlowNotFound
+	case spErr.HttpCode == http.StatusNotFound && strings.Contains(description, "contact"):
+		return ErrChatbotContactNotFound
+	case spErr.HttpCode == http.StatusNotFound && strings.Contains(description, "bot"):
+		return ErrBotNotFound
+	}
+	return err
+}
+
+func (c *chatbot) Bots() ([]Bot, error) {
+	return c.BotsContext(context.Background())
+}
+
+func (c *chatbot) BotsContext(ctx context.Context) ([]Bot, error) {
+	path := fmt.Sprintf("/%s/bots", c.channel)
+
+	body, err := c.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var respData struct {
+		Data []botRaw `json:"data"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, c.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	bots := make([]Bot, 0, len(respData.Data))
+	for _, raw := range respData.Data {
+		bots = append(bots, Bot{
+			ID:          raw.ID,
+			Name:        raw.ChannelData.Name,
+			Username:    raw.ChannelData.Username,
+			Status:      toInt(raw.Status),
+			InboxTotal:  toInt(raw.Inbox.Total),
+			InboxUnread: toInt(raw.Inbox.Unread),
+			Created:     chatbotTime(raw.CreatedAt),
+		})
+	}
+	return bots, nil
+}
+
+// Contacts returns the contacts of the bot, e.g. to resolve a user to the contact id messages are sent to
+func (c *chatbot) Contacts(botID string, limit int, offset int) ([]ChatbotContact, error) {
+	return c.ContactsContext(context.Background(), botID, limit, offset)
+}
+
+func (c *chatbot) ContactsContext(ctx context.Context, botID string, limit int, offset int) ([]ChatbotContact, error) {
+	path := fmt.Sprintf("/%s/contacts", c.channel)
+	data := map[string]interface{}{
+		"bot_id": botID,
+		"limit":  fmt.Sprint(limit),
+		"offset": fmt.Sprint(offset),
+	}
+
+	body, err := c.Client.makeRequest(ctx, path, "GET", data, true)
+	if err != nil {
+		return nil, chatbotError(err)
+	}
+
+	var respData struct {
+		Data []chatbotContactRaw `json:"data"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, c.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	contacts := make([]ChatbotContact, 0, len(respData.Data))
+	for _, raw := range respData.Data {
+		contacts = append(contacts, raw.contact())
+	}
+	return contacts, nil
+}
+
+// messagingWindowErrorCodes are the error codes the channels reject a message sent after the messaging window
+// with: WhatsApp re-engagement (131047), Messenger (2018278) and Instagram (2534022) outside of the allowed window
+var messagingWindowErrorCodes = map[int]bool{
+	131047:  true,
+	2018278: true,
+	2534022: true,
+}
+
+// sendError is chatbotError for the free-form messages: only they are subject to the messaging window,
+// so a client error with one of messagingWindowErrorCodes is mapped to ErrMessagingWindowClosed
+func sendError(err error) error {
+	spErr, ok := err.(*SendpulseError)
+	if ok && spErr.HttpCode >= http.StatusBadRequest && spErr.HttpCode < http.StatusInternalServerError &&
+		messagingWindowErrorCodes[spErr.ErrorCode] {
+		return ErrMessagingWindowClosed
+	}
+	return chatbotError(err)
+}
+
+// sendMessage posts a channel-specific message payload to the contact
+func (c *chatbot) sendMessage(ctx context.Context, contactID string, message interface{}) error {
+	path := fmt.Sprintf("/%s/contacts/send", c.channel)
+
+	body, err := c.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"contact_id": contactID,
+		"message":    message,
+	})
+	if err != nil {
+		return sendError(err)
+	}
+
+	return c.Client.checkSuccess("POST", path, body)
+}
+
+// RunFlow starts the flow for the contact. externalData is available to the flow as variables
+func (c *chatbot) RunFlow(contactID string, flowID string, externalData map[string]interface{}) error {
+	return c.RunFlowContext(context.Background(), contactID, flowID, externalData)
+}
+
+func (c *chatbot) RunFlowContext(ctx context.Context, contactID string, flowID string, externalData map[string]interface{}) error {
+	path := fmt.Sprintf("/%s/flows/run", c.channel)
+	return c.runFlow(ctx, path, map[string]interface{}{
+		"contact_id": contactID,
+		"flow_id":    flowID,
+	}, externalData)
+}
+
+// RunFlowByTrigger starts the flow bound to the trigger keyword for the contact
+func (c *chatbot) RunFlowByTrigger(contactID string, triggerKeyword string, externalData map[string]interface{}) error {
+	return c.RunFlowByTriggerContext(context.Background(), contactID, triggerKeyword, externalData)
+}
+
+func (c *chatbot) RunFlowByTriggerContext(ctx context.Context, contactID string, triggerKeyword string, externalData map[string]interface{}) error {
+	path := fmt.Sprintf("/%s/flows/runByTrigger", c.channel)
+	return c.runFlow(ctx, path, map[string]interface{}{
+		"contact_id":      contactID,
+		"trigger_keyword": triggerKeyword,
+	}, externalData)
+}
+
+func (c *chatbot) runFlow(ctx context.Context, path string, payload map[string]interface{}, externalData map[string]interface{}) error {
+	if externalData == nil {
+		externalData = make(map[string]interface{})
+	}
+	payload["external_data"] = externalData
+
+	body, err := c.Client.makeJSONRequest(ctx, path, "POST", payload)
+	if err != nil {
+		return chatbotError(err)
+	}
+
+	return c.Client.checkSuccess("POST", path, body)
+}
+
+// GetContact returns the contact with its current variables
+func (c *chatbot) GetContact(contactID string) (*ChatbotContact, error) {
+	return c.GetContactContext(context.Background(), contactID)
+}
+
+func (c *chatbot) GetContactContext(ctx context.Context, contactID string) (*ChatbotContact, error) {
+	path := fmt.Sprintf("/%s/contacts/get", c.channel)
+	data := map[string]interface{}{
+		"id": contactID,
+	}
+
+	body, err := c.Client.makeRequest(ctx, path, "GET", data, true)
+	if err != nil {
+		return nil, chatbotError(err)
+	}
+
+	var respData struct {
+		Data chatbotContactRaw `json:"data"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, c.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	contact := respData.Data.contact()
+	return &contact, nil
+}
+
+// SetVariable sets a variable of the contact. Strings, numbers, booleans and time.Time values keep their JSON types
+func (c *chatbot) SetVariable(contactID string, name string, value interface{}) error {
+	return c.SetVariableContext(context.Background(), contactID, name, value)
+}
+
+func (c *chatbot) SetVariableContext(ctx context.Context, contactID string, name string, value interface{}) error {
+	path := fmt.Sprintf("/%s/contacts/setVariable", c.channel)
+
+	body, err := c.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"contact_id":     contactID,
+		"variable_name":  name,
+		"variable_value": value,
+	})
+	if err != nil {
+		return chatbotError(err)
+	}
+
+	return c.Client.checkSuccess("POST", path, body)
+}
+
+type chatbotVariableRaw struct {
+	Name  string      `json:"variable_name"`
+	Value interface{} `json:"variable_value"`
+}
+
+// SetVariables sets several variables of the contact in one request
+func (c *chatbot) SetVariables(contactID string, variables map[string]interface{}) error {
+	return c.SetVariablesContext(context.Background(), contactID, variables)
+}
+
+func (c *chatbot) SetVariablesContext(ctx context.Context, contactID string, variables map[string]interface{}) error {
+	path := fmt.Sprintf("/%s/contacts/setVariable", c.channel)
+
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	variablesData := make([]chatbotVariableRaw, 0, len(names))
+	for _, name := range names {
+		variablesData = append(variablesData, chatbotVariableRaw{Name: name, Value: variables[name]})
+	}
+
+	body, err := c.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"contact_id": contactID,
+		"variables":  variablesData,
+	})
+	if err != nil {
+		return chatbotError(err)
+	}
+
+	return c.Client.checkSuccess("POST", path, body)
+}
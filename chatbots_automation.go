@@ -0,0 +1,81 @@
+package sendpulse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrChatbotContactNotFound is returned when the contact doesn't exist or isn't a subscriber of the bot
+	ErrChatbotContactNotFound = errors.New("chatbot contact not found")
+	// ErrInvalidPauseDuration is returned without calling the API when the automation pause is shorter than a minute
+	ErrInvalidPauseDuration = errors.New("automation pause must be at least one minute")
+	// ErrContactNotInFlow is returned when the contact isn't subscribed to the flow it is removed from
+	ErrContactNotInFlow = errors.New("chatbot contact is not in the flow")
+)
+
+// PauseAutomation stops the flows of the bot for the contact for the duration, rounded down to minutes,
+// e.g. once they converted or are talking to an operator. The pause applies to all the flows:
+// use UnsubscribeContactFromFlow to stop a single flow
+func (c *chatbot) PauseAutomation(contactID string, duration time.Duration) error {
+	return c.PauseAutomationContext(context.Background(), contactID, duration)
+}
+
+func (c *chatbot) PauseAutomationContext(ctx context.Context, contactID string, duration time.Duration) error {
+	path := fmt.Sprintf("/%s/contacts/setPauseAutomation", c.channel)
+
+	minutes := int(duration / time.Minute)
+	if minutes < 1 {
+		return ErrInvalidPauseDuration
+	}
+
+	body, err := c.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"contact_id": contactID,
+		"minutes":    minutes,
+	})
+	if err != nil {
+		return chatbotError(err)
+	}
+
+	return c.Client.checkSuccess("POST", path, body)
+}
+
+// ResumeAutomation removes the automation pause of the contact before it expires
+func (c *chatbot) ResumeAutomation(contactID string) error {
+	return c.ResumeAutomationContext(context.Background(), contactID)
+}
+
+func (c *chatbot) ResumeAutomationContext(ctx context.Context, contactID string) error {
+	path := fmt.Sprintf("/%s/contacts/deletePauseAutomation", c.channel)
+
+	body, err := c.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"contact_id": contactID,
+	})
+	if err != nil {
+		return chatbotError(err)
+	}
+
+	return c.Client.checkSuccess("POST", path, body)
+}
+
+// UnsubscribeContactFromFlow stops a single flow for the contact, e.g. once they converted, leaving the other
+// flows of the bot running. The chatbots API can't pause a contact in one flow, so it is removed from the flow instead
+func (c *chatbot) UnsubscribeContactFromFlow(contactID string, flowID string) error {
+	return c.UnsubscribeContactFromFlowContext(context.Background(), contactID, flowID)
+}
+
+func (c *chatbot) UnsubscribeContactFromFlowContext(ctx context.Context, contactID string, flowID string) error {
+	path := fmt.Sprintf("/%s/flows/unsubscribe", c.channel)
+
+	body, err := c.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"contact_id": contactID,
+		"flow_id":    flowID,
+	})
+	if err != nil {
+		return chatbotError(err)
+	}
+
+	return c.Client.checkSuccess("POST", path, body)
+}
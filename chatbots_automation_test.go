@@ -0,0 +1,117 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestChatbot_PauseAutomation(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/telegram/contacts/setPauseAutomation",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"success": true}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	err := spClient.Telegram.PauseAutomation("contact1", 2*time.Hour)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"contact_id": "contact1", "minutes": 120}`, requestBody)
+
+	err = spClient.Telegram.PauseAutomation("contact1", 30*time.Second)
+	assert.Equal(t, ErrInvalidPauseDuration, err)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestChatbot_PauseAutomation_ContactNotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/whatsapp/contacts/setPauseAutomation",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"success": false, "message": "Contact not found"}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	err := spClient.WhatsApp.PauseAutomation("contact1", time.Hour)
+	assert.Equal(t, ErrChatbotContactNotFound, err)
+}
+
+func TestChatbot_ResumeAutomation(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/messenger/contacts/deletePauseAutomation",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"success": true}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	err := spClient.Messenger.ResumeAutomation("contact1")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"contact_id": "contact1"}`, requestBody)
+}
+
+func TestChatbot_UnsubscribeContactFromFlow(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/telegram/flows/unsubscribe",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"success": true}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	err := spClient.Telegram.UnsubscribeContactFromFlow("contact1", "flow1")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"contact_id": "contact1", "flow_id": "flow1"}`, requestBody)
+}
+
+func TestChatbot_UnsubscribeContactFromFlow_NotInFlow(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/whatsapp/flows/unsubscribe",
+		httpmock.NewStringResponder(http.StatusUnprocessableEntity, `{"success": false, "message": "Contact is not in flow"}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	err := spClient.WhatsApp.UnsubscribeContactFromFlow("contact1", "flow1")
+	assert.Equal(t, ErrContactNotInFlow, err)
+}
+
+func TestChatbot_UnsubscribeContactFromFlow_FlowNotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/messenger/flows/unsubscribe",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"success": false, "message": "Flow not found"}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	err := spClient.Messenger.UnsubscribeContactFromFlow("contact1", "flow1")
+	assert.Equal(t, ErrFlowNotFound, err)
+}
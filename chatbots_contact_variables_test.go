@@ -0,0 +1,106 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestChatbot_SetVariable_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/telegram/contacts/setVariable",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"success": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Telegram.SetVariable("contact1", "bonus", 150)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"contact_id": "contact1", "variable_name": "bonus", "variable_value": 150}`, requestBody)
+}
+
+func TestChatbot_SetVariable_WindowMessage(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/whatsapp/contacts/setVariable",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"success": false, "error_code": 131047, "message": "Variable window_size is invalid"}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	err := spClient.WhatsApp.SetVariable("contact1", "window_size", 24)
+	assert.NotEqual(t, ErrMessagingWindowClosed, err)
+	_, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+}
+
+func TestChatbot_SetVariables_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/whatsapp/contacts/setVariable",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"success": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.WhatsApp.SetVariables("contact1", map[string]interface{}{
+		"plan":  "pro",
+		"bonus": 150.5,
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"contact_id": "contact1",
+		"variables": [
+			{"variable_name": "bonus", "variable_value": 150.5},
+			{"variable_name": "plan", "variable_value": "pro"}
+		]
+	}`, requestBody)
+}
+
+func TestChatbot_GetContact_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/telegram/contacts/get?id=contact1",
+		httpmock.NewStringResponder(http.StatusOK, `{"success": true, "data": {"id": "contact1", "bot_id": "bot1", "status": 1, "variables": {"plan": "pro", "bonus": 150}}}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	contact, err := spClient.Telegram.GetContact("contact1")
+	assert.NoError(t, err)
+	assert.Equal(t, "bot1", contact.BotID)
+	assert.Equal(t, map[string]interface{}{"plan": "pro", "bonus": float64(150)}, contact.Variables)
+}
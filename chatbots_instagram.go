@@ -0,0 +1,20 @@
+package sendpulse
+
+import "context"
+
+type instagram struct {
+	chatbot
+}
+
+// SendMessage sends a text to the contact. Instagram allows it only within 24 hours
+// of the contact's last message, otherwise ErrMessagingWindowClosed is returned
+func (i *instagram) SendMessage(contactID string, text string) error {
+	return i.SendMessageContext(context.Background(), contactID, text)
+}
+
+func (i *instagram) SendMessageContext(ctx context.Context, contactID string, text string) error {
+	return i.sendMessage(ctx, contactID, map[string]interface{}{
+		"type": "text",
+		"text": text,
+	})
+}
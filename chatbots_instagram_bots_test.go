@@ -0,0 +1,29 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestInstagram_Bots_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/instagram/bots",
+		httpmock.NewStringResponder(http.StatusOK, `{"success": true, "data": [{"id": "bot1", "channel_data": {"name": "Shop", "username": "shop"}, "status": 3}]}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	bots, err := spClient.Instagram.Bots()
+	assert.NoError(t, err)
+	assert.Equal(t, []Bot{{ID: "bot1", Name: "Shop", Username: "shop", Status: 3}}, bots)
+}
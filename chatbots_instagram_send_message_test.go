@@ -0,0 +1,54 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestInstagram_SendMessage_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/instagram/contacts/send",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"success": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Instagram.SendMessage("contact1", "Thanks for the follow!")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"contact_id": "contact1", "message": {"type": "text", "text": "Thanks for the follow!"}}`, requestBody)
+}
+
+func TestInstagram_SendMessage_WindowClosed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/instagram/contacts/send",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"success": false, "error_code": 2534022, "message": "This message is sent outside of allowed window"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Instagram.SendMessage("contact1", "Hi")
+	assert.Equal(t, ErrMessagingWindowClosed, err)
+}
@@ -0,0 +1,53 @@
+package sendpulse
+
+import "context"
+
+type messenger struct {
+	chatbot
+}
+
+// MessengerMessage is a text message with optional quick replies shown as buttons under it
+type MessengerMessage struct {
+	Text         string
+	QuickReplies []QuickReply
+}
+
+// QuickReply is a button sending Payload to the bot when tapped
+type QuickReply struct {
+	Title   string
+	Payload string
+}
+
+type messengerMessageRaw struct {
+	MessagingType string `json:"messaging_type"`
+	Message       struct {
+		Text         string              `json:"text"`
+		QuickReplies []messengerReplyRaw `json:"quick_replies,omitempty"`
+	} `json:"message"`
+}
+
+type messengerReplyRaw struct {
+	ContentType string `json:"content_type"`
+	Title       string `json:"title"`
+	Payload     string `json:"payload"`
+}
+
+// SendMessage sends a message as a response to the contact, which Messenger allows only within 24 hours
+// of the contact's last message. Otherwise ErrMessagingWindowClosed is returned
+func (m *messenger) SendMessage(contactID string, message MessengerMessage) error {
+	return m.SendMessageContext(context.Background(), contactID, message)
+}
+
+func (m *messenger) SendMessageContext(ctx context.Context, contactID string, message MessengerMessage) error {
+	raw := messengerMessageRaw{MessagingType: "RESPONSE"}
+	raw.Message.Text = message.Text
+	for _, reply := range message.QuickReplies {
+		raw.Message.QuickReplies = append(raw.Message.QuickReplies, messengerReplyRaw{
+			ContentType: "text",
+			Title:       reply.Title,
+			Payload:     reply.Payload,
+		})
+	}
+
+	return m.sendMessage(ctx, contactID, raw)
+}
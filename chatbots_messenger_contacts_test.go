@@ -0,0 +1,30 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestMessenger_Contacts_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/messenger/contacts?bot_id=bot1&limit=10&offset=20",
+		httpmock.NewStringResponder(http.StatusOK, `{"success": true, "data": [{"id": "contact1", "bot_id": "bot1", "channel_data": {"name": "Alice Smith"}, "status": 1}]}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	contacts, err := spClient.Messenger.Contacts("bot1", 10, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(contacts))
+	assert.Equal(t, "Alice Smith", contacts[0].Name)
+}
@@ -0,0 +1,69 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestMessenger_SendMessage_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/messenger/contacts/send",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"success": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Messenger.SendMessage("contact1", MessengerMessage{
+		Text:         "Did you like the order?",
+		QuickReplies: []QuickReply{{Title: "Yes", Payload: "LIKE"}, {Title: "No", Payload: "DISLIKE"}},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"contact_id": "contact1",
+		"message": {
+			"messaging_type": "RESPONSE",
+			"message": {
+				"text": "Did you like the order?",
+				"quick_replies": [
+					{"content_type": "text", "title": "Yes", "payload": "LIKE"},
+					{"content_type": "text", "title": "No", "payload": "DISLIKE"}
+				]
+			}
+		}
+	}`, requestBody)
+}
+
+func TestMessenger_SendMessage_WindowClosed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/messenger/contacts/send",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"success": false, "error_code": 2018278, "message": "This message is sent outside of allowed window"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Messenger.SendMessage("contact1", MessengerMessage{Text: "Hi"})
+	assert.Equal(t, ErrMessagingWindowClosed, err)
+}
@@ -0,0 +1,87 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestChatbot_RunFlow_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/telegram/flows/run",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"success": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Telegram.RunFlow("contact1", "flow1", map[string]interface{}{
+		"order_id": 1001,
+		"paid":     true,
+		"name":     "Alice",
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"contact_id": "contact1",
+		"flow_id": "flow1",
+		"external_data": {"order_id": 1001, "paid": true, "name": "Alice"}
+	}`, requestBody)
+}
+
+func TestChatbot_RunFlow_NotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/whatsapp/flows/run",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"success": false, "message": "Flow not found"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.WhatsApp.RunFlow("contact1", "unknown", nil)
+	assert.Equal(t, ErrFlowNotFound, err)
+}
+
+func TestChatbot_RunFlowByTrigger_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/messenger/flows/runByTrigger",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"success": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Messenger.RunFlowByTrigger("contact1", "start", nil)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"contact_id": "contact1", "trigger_keyword": "start", "external_data": {}}`, requestBody)
+}
@@ -0,0 +1,52 @@
+package sendpulse
+
+import "context"
+
+type telegram struct {
+	chatbot
+}
+
+// TelegramMessage is a text message with optional inline buttons, one per row
+type TelegramMessage struct {
+	Text    string
+	Buttons []TelegramButton
+}
+
+// TelegramButton opens the URL or, if it's empty, sends CallbackData to the bot
+type TelegramButton struct {
+	Text         string `json:"text"`
+	URL          string `json:"url,omitempty"`
+	CallbackData string `json:"callback_data,omitempty"`
+}
+
+type telegramMessageRaw struct {
+	Type        string                  `json:"type"`
+	Text        string                  `json:"text"`
+	ReplyMarkup *telegramReplyMarkupRaw `json:"reply_markup,omitempty"`
+}
+
+type telegramReplyMarkupRaw struct {
+	InlineKeyboard [][]TelegramButton `json:"inline_keyboard"`
+}
+
+// SendMessage sends the text message to the contact. A missing bot gives ErrBotNotFound and a contact that isn't
+// a subscriber of the bot ErrChatbotContactNotFound. Telegram has no messaging window, bots can write to their
+// subscribers at any time, so unlike the other channels ErrMessagingWindowClosed is never returned
+func (t *telegram) SendMessage(contactID string, message TelegramMessage) error {
+	return t.SendMessageContext(context.Background(), contactID, message)
+}
+
+func (t *telegram) SendMessageContext(ctx context.Context, contactID string, message TelegramMessage) error {
+	raw := telegramMessageRaw{
+		Type: "text",
+		Text: message.Text,
+	}
+	if len(message.Buttons) != 0 {
+		raw.ReplyMarkup = &telegramReplyMarkupRaw{}
+		for _, button := range message.Buttons {
+			raw.ReplyMarkup.InlineKeyboard = append(raw.ReplyMarkup.InlineKeyboard, []TelegramButton{button})
+		}
+	}
+
+	return t.sendMessage(ctx, contactID, raw)
+}
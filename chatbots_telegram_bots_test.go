@@ -0,0 +1,63 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTelegram_Bots_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/telegram/bots",
+		httpmock.NewStringResponder(http.StatusOK, `{"success": true, "data": [{
+			"id": "5f8d6a1b2c3d4e5f6a7b8c9d",
+			"channel_data": {"name": "Support", "username": "shop_support_bot"},
+			"inbox": {"total": 120, "unread": "3"},
+			"status": 3,
+			"created_at": "2030-01-02T07:30:00+00:00"
+		}]}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	bots, err := spClient.Telegram.Bots()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(bots))
+	assert.Equal(t, "5f8d6a1b2c3d4e5f6a7b8c9d", bots[0].ID)
+	assert.Equal(t, "Support", bots[0].Name)
+	assert.Equal(t, "shop_support_bot", bots[0].Username)
+	assert.Equal(t, 120, bots[0].InboxTotal)
+	assert.Equal(t, 3, bots[0].InboxUnread)
+	assert.True(t, bots[0].Created.Equal(time.Date(2030, 1, 2, 7, 30, 0, 0, time.UTC)))
+}
+
+func TestTelegram_Bots_BadJson(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/telegram/bots",
+		httpmock.NewStringResponder(http.StatusOK, `Invalid json`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	bots, err := spClient.Telegram.Bots()
+	assert.Nil(t, bots)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
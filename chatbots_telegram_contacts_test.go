@@ -0,0 +1,62 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestTelegram_Contacts_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/telegram/contacts?bot_id=bot1&limit=10&offset=0",
+		httpmock.NewStringResponder(http.StatusOK, `{"success": true, "data": [{
+			"id": "contact1",
+			"bot_id": "bot1",
+			"channel_data": {"first_name": "Alice", "last_name": "Smith", "username": "alice"},
+			"status": 1,
+			"variables": {"plan": "pro"}
+		}]}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	contacts, err := spClient.Telegram.Contacts("bot1", 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []ChatbotContact{{
+		ID:        "contact1",
+		BotID:     "bot1",
+		Name:      "Alice Smith",
+		Username:  "alice",
+		Status:    1,
+		Variables: map[string]interface{}{"plan": "pro"},
+	}}, contacts)
+}
+
+func TestTelegram_Contacts_BotNotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/telegram/contacts?bot_id=bot1&limit=10&offset=0",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"success": false, "message": "Bot not found"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	contacts, err := spClient.Telegram.Contacts("bot1", 10, 0)
+	assert.Nil(t, contacts)
+	assert.Equal(t, ErrBotNotFound, err)
+}
@@ -0,0 +1,110 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestTelegram_SendMessage_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/telegram/contacts/send",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"success": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Telegram.SendMessage("contact1", TelegramMessage{
+		Text: "Your order has shipped",
+		Buttons: []TelegramButton{
+			{Text: "Track", URL: "https://example.com/track"},
+			{Text: "Thanks", CallbackData: "thanks"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"contact_id": "contact1",
+		"message": {
+			"type": "text",
+			"text": "Your order has shipped",
+			"reply_markup": {"inline_keyboard": [
+				[{"text": "Track", "url": "https://example.com/track"}],
+				[{"text": "Thanks", "callback_data": "thanks"}]
+			]}
+		}
+	}`, requestBody)
+}
+
+func TestTelegram_SendMessage_NotWindowCode(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/telegram/contacts/send",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"success": false, "message": "Messaging window is closed"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Telegram.SendMessage("contact1", TelegramMessage{Text: "Hi"})
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, http.StatusBadRequest, spErr.HttpCode)
+}
+
+func TestTelegram_SendMessage_NotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/telegram/contacts/send",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"success": false, "message": "Bot not found"}`))
+	err := spClient.Telegram.SendMessage("contact1", TelegramMessage{Text: "Hi"})
+	assert.Equal(t, ErrBotNotFound, err)
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/telegram/contacts/send",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"success": false, "message": "Contact not found"}`))
+	err = spClient.Telegram.SendMessage("contact1", TelegramMessage{Text: "Hi"})
+	assert.Equal(t, ErrChatbotContactNotFound, err)
+}
+
+func TestTelegram_SendMessage_InvalidResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/telegram/contacts/send",
+		httpmock.NewStringResponder(http.StatusOK, `{"success": false}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Telegram.SendMessage("contact1", TelegramMessage{Text: "Hi"})
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
@@ -0,0 +1,171 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+type whatsapp struct {
+	chatbot
+}
+
+// TemplateComponent fills a part of a WhatsApp template: Type is "header", "body" or "button".
+// Buttons also need SubType ("quick_reply" or "url") and Index, their position in the template
+type TemplateComponent struct {
+	Type       string              `json:"type"`
+	SubType    string              `json:"sub_type,omitempty"`
+	Index      string              `json:"index,omitempty"`
+	Parameters []TemplateParameter `json:"parameters"`
+}
+
+// TemplateParameter is a value of a template placeholder. Type is usually "text"
+type TemplateParameter struct {
+	Type    string `json:"type"`
+	Text    string `json:"text,omitempty"`
+	Payload string `json:"payload,omitempty"`
+}
+
+type whatsappTemplateRaw struct {
+	Name     string `json:"name"`
+	Language struct {
+		Code string `json:"code"`
+	} `json:"language"`
+	Components []TemplateComponent `json:"components,omitempty"`
+}
+
+// SendText sends a free-form text. WhatsApp allows it only within 24 hours of the contact's last message,
+// otherwise ErrMessagingWindowClosed is returned and a template must be sent instead
+func (w *whatsapp) SendText(contactID string, text string) error {
+	return w.SendTextContext(context.Background(), contactID, text)
+}
+
+func (w *whatsapp) SendTextContext(ctx context.Context, contactID string, text string) error {
+	return w.sendMessage(ctx, contactID, map[string]interface{}{
+		"type": "text",
+		"text": map[string]string{
+			"body": text,
+		},
+	})
+}
+
+// SendTemplate sends a pre-approved template message, which is allowed outside of the 24-hour window
+func (w *whatsapp) SendTemplate(contactID string, templateName string, language string, components []TemplateComponent) error {
+	return w.SendTemplateContext(context.Background(), contactID, templateName, language, components)
+}
+
+func (w *whatsapp) SendTemplateContext(ctx context.Context, contactID string, templateName string, language string, components []TemplateComponent) error {
+	path := fmt.Sprintf("/%s/contacts/sendTemplate", w.channel)
+
+	template := whatsappTemplateRaw{
+		Name:       templateName,
+		Components: components,
+	}
+	template.Language.Code = language
+
+	body, err := w.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"contact_id": contactID,
+		"template":   template,
+	})
+	if err != nil {
+		return chatbotError(err)
+	}
+
+	return w.Client.checkSuccess("POST", path, body)
+}
+
+// ErrMediaTooLarge is returned without calling the API when a file exceeds the WhatsApp size limit for its type
+var ErrMediaTooLarge = errors.New("media file is too large")
+
+// WhatsApp media size limits by type
+const (
+	WhatsAppImageMaxSize    = 5 << 20
+	WhatsAppVideoMaxSize    = 16 << 20
+	WhatsAppAudioMaxSize    = 16 << 20
+	WhatsAppDocumentMaxSize = 100 << 20
+)
+
+// whatsappMediaMaxSize returns the size limit for the file judging by its extension
+func whatsappMediaMaxSize(filename string) int {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".jpg", ".jpeg", ".png":
+		return WhatsAppImageMaxSize
+	case ".mp4", ".3gp":
+		return WhatsAppVideoMaxSize
+	case ".aac", ".amr", ".mp3", ".m4a", ".ogg", ".opus":
+		return WhatsAppAudioMaxSize
+	}
+	return WhatsAppDocumentMaxSize
+}
+
+// UploadMedia uploads a file to be sent with SendImage or SendDocument and returns its media id
+func (w *whatsapp) UploadMedia(botID string, filename string, data []byte) (string, error) {
+	return w.UploadMediaContext(context.Background(), botID, filename, data)
+}
+
+func (w *whatsapp) UploadMediaContext(ctx context.Context, botID string, filename string, data []byte) (string, error) {
+	path := fmt.Sprintf("/%s/media/upload", w.channel)
+
+	if maxSize := whatsappMediaMaxSize(filename); len(data) > maxSize {
+		return "", fmt.Errorf("%w: %s is %d bytes, the limit is %d", ErrMediaTooLarge, filename, len(data), maxSize)
+	}
+
+	body, err := w.Client.makeMultipartRequest(ctx, path, map[string]string{"bot_id": botID}, "file", filename, data)
+	if err != nil {
+		return "", chatbotError(err)
+	}
+
+	if err := w.Client.checkSuccess("POST", path, body); err != nil {
+		return "", err
+	}
+
+	var respData struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil || respData.Data.ID == "" {
+		return "", w.Client.invalidResponse("POST", path, body, "media id is missing")
+	}
+
+	return respData.Data.ID, nil
+}
+
+func (w *whatsapp) SendImage(contactID string, mediaID string, caption string) error {
+	return w.SendImageContext(context.Background(), contactID, mediaID, caption)
+}
+
+func (w *whatsapp) SendImageContext(ctx context.Context, contactID string, mediaID string, caption string) error {
+	image := map[string]string{
+		"id": mediaID,
+	}
+	if caption != "" {
+		image["caption"] = caption
+	}
+
+	return w.sendMessage(ctx, contactID, map[string]interface{}{
+		"type":  "image",
+		"image": image,
+	})
+}
+
+func (w *whatsapp) SendDocument(contactID string, mediaID string, filename string) error {
+	return w.SendDocumentContext(context.Background(), contactID, mediaID, filename)
+}
+
+func (w *whatsapp) SendDocumentContext(ctx context.Context, contactID string, mediaID string, filename string) error {
+	document := map[string]string{
+		"id": mediaID,
+	}
+	if filename != "" {
+		document["filename"] = filename
+	}
+
+	return w.sendMessage(ctx, contactID, map[string]interface{}{
+		"type":     "document",
+		"document": document,
+	})
+}
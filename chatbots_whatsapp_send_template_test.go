@@ -0,0 +1,72 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestWhatsApp_SendTemplate_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/whatsapp/contacts/sendTemplate",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"success": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.WhatsApp.SendTemplate("contact1", "appointment_reminder", "en", []TemplateComponent{{
+		Type: "body",
+		Parameters: []TemplateParameter{
+			{Type: "text", Text: "Alice"},
+			{Type: "text", Text: "10:30"},
+		},
+	}})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"contact_id": "contact1",
+		"template": {
+			"name": "appointment_reminder",
+			"language": {"code": "en"},
+			"components": [{
+				"type": "body",
+				"parameters": [{"type": "text", "text": "Alice"}, {"type": "text", "text": "10:30"}]
+			}]
+		}
+	}`, requestBody)
+}
+
+func TestWhatsApp_SendTemplate_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/whatsapp/contacts/sendTemplate",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"success": false, "message": "Template not found"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.WhatsApp.SendTemplate("contact1", "unknown", "en", nil)
+	spErr, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+	assert.Equal(t, "Template not found", spErr.ErrorDescription)
+}
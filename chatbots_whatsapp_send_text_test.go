@@ -0,0 +1,54 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestWhatsApp_SendText_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/whatsapp/contacts/send",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"success": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.WhatsApp.SendText("contact1", "See you tomorrow")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"contact_id": "contact1", "message": {"type": "text", "text": {"body": "See you tomorrow"}}}`, requestBody)
+}
+
+func TestWhatsApp_SendText_WindowClosed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/whatsapp/contacts/send",
+		httpmock.NewStringResponder(http.StatusUnprocessableEntity, `{"success": false, "error_code": 131047, "message": "More than 24 hours have passed since the customer last replied"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.WhatsApp.SendText("contact1", "See you tomorrow")
+	assert.Equal(t, ErrMessagingWindowClosed, err)
+}
@@ -0,0 +1,68 @@
+package sendpulse
+
+import (
+	"errors"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestWhatsApp_UploadMedia_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var botID, filename string
+	var content []byte
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/whatsapp/media/upload",
+		func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseMultipartForm(1 << 20); err != nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, err.Error()), nil
+			}
+			botID = req.FormValue("bot_id")
+			file, header, err := req.FormFile("file")
+			if err != nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, err.Error()), nil
+			}
+			defer file.Close()
+			filename = header.Filename
+			content, _ = ioutil.ReadAll(file)
+			return httpmock.NewStringResponse(http.StatusOK, `{"success": true, "data": {"id": "media1"}}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	data := []byte("%PDF-1.4\x00\x01binary")
+	mediaID, err := spClient.WhatsApp.UploadMedia("bot1", "invoice.pdf", data)
+	assert.NoError(t, err)
+	assert.Equal(t, "media1", mediaID)
+	assert.Equal(t, "bot1", botID)
+	assert.Equal(t, "invoice.pdf", filename)
+	assert.Equal(t, data, content)
+}
+
+func TestWhatsApp_UploadMedia_TooLarge(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	mediaID, err := spClient.WhatsApp.UploadMedia("bot1", "photo.JPG", make([]byte, WhatsAppImageMaxSize+1))
+	assert.Equal(t, "", mediaID)
+	assert.True(t, errors.Is(err, ErrMediaTooLarge))
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
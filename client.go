@@ -2,11 +2,17 @@ package sendpulse
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -14,133 +20,674 @@ import (
 
 type SendpulseError struct {
 	HttpCode int
-	Url      string
-	Body     string
-	Message  string
+	// Method and Url are the HTTP method and the full URL of the failed request
+	Method  string
+	Url     string
+	Body    string
+	Message string
+	// ErrorCode and ErrorDescription are taken from the JSON error body if SendPulse returned one
+	ErrorCode        int
+	ErrorDescription string
+	// RetryAfter is how long to wait before retrying a rate limited (429) request, taken from the Retry-After header
+	RetryAfter time.Duration
 }
 
 func (e *SendpulseError) Error() string {
-	return fmt.Sprintf("Http code: %d, url: %s, body: %s, message: %s", e.HttpCode, e.Url, e.Body, e.Message)
+	return fmt.Sprintf("Http code: %d, method: %s, url: %s, body: %s, message: %s", e.HttpCode, e.Method, e.Url, e.Body, e.Message)
+}
+
+// IsRateLimited reports whether the request was rejected because of too many requests
+func (e *SendpulseError) IsRateLimited() bool {
+	return e.HttpCode == http.StatusTooManyRequests
+}
+
+// IsValidationError reports whether SendPulse rejected the request parameters
+func (e *SendpulseError) IsValidationError() bool {
+	return e.HttpCode == http.StatusBadRequest || e.HttpCode == http.StatusUnprocessableEntity
+}
+
+type errorResponse struct {
+	ErrorCode        interface{} `json:"error_code"`
+	Message          string      `json:"message"`
+	Error            string      `json:"error"`
+	ErrorDescription string      `json:"error_description"`
+}
+
+// newResponseError builds an error for a failed request falling back to the raw body if it isn't JSON
+func newResponseError(statusCode int, method string, url string, body []byte) *SendpulseError {
+	spErr := &SendpulseError{HttpCode: statusCode, Method: method, Url: url, Body: string(body), Message: ""}
+
+	var errResp errorResponse
+	if err := json.Unmarshal(body, &errResp); err != nil {
+		return spErr
+	}
+
+	spErr.ErrorCode = toInt(errResp.ErrorCode)
+	for _, description := range []string{errResp.Message, errResp.ErrorDescription, errResp.Error} {
+		if description != "" {
+			spErr.ErrorDescription = description
+			break
+		}
+	}
+	return spErr
+}
+
+// IsAuthError reports whether SendPulse rejected the credentials: the request failed with 401 even with a fresh token
+func IsAuthError(err error) bool {
+	var spErr *SendpulseError
+	return errors.As(err, &spErr) && spErr.HttpCode == http.StatusUnauthorized
 }
 
 type client struct {
-	config    Config
-	token     string
-	tokenLock *sync.RWMutex
+	config      Config
+	httpClient  *http.Client
+	token       string
+	tokenExpiry time.Time
+	tokenCall   *tokenCall
+	tokenLock   *sync.Mutex
+	sent        idempotencyCache
+	booksTotal  booksTotalCache
 }
 
-func NewClient(config Config) *client {
-	c := &client{config, "", new(sync.RWMutex)}
+func NewClient(config Config, opts ...Option) *client {
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	config.BaseURL = strings.TrimRight(config.BaseURL, "/")
+	if config.BaseURL == "" {
+		config.BaseURL = apiBaseUrl
+	}
+	if config.UserAgent == "" {
+		config.UserAgent = defaultUserAgent
+	}
+	if config.TokenExpiryBuffer == 0 {
+		config.TokenExpiryBuffer = defaultTokenExpiryBuffer
+	}
+	if config.MaxAttempts == 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.RetryBackoffBase == 0 {
+		config.RetryBackoffBase = defaultRetryBackoffBase
+	}
+	if config.RetryBackoffMax == 0 {
+		config.RetryBackoffMax = defaultRetryBackoffMax
+	}
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: time.Duration(config.Timeout) * time.Second,
+		}
+	}
+	c := &client{
+		config:     config,
+		httpClient: httpClient,
+		tokenLock:  new(sync.Mutex),
+	}
 	return c
 }
 
 const apiBaseUrl = "https://api.sendpulse.com"
 
-func (c *client) getToken() (string, error) {
-	c.tokenLock.RLock()
-	token := c.token
-	c.tokenLock.RUnlock()
+const defaultUserAgent = "sendpulse-sdk-go/" + Version
 
-	if token != "" {
-		return token, nil
+const (
+	defaultTokenExpiryBuffer = 60 * time.Second
+	defaultMaxAttempts       = 3
+	defaultRetryBackoffBase  = 100 * time.Millisecond
+	defaultRetryBackoffMax   = 2 * time.Second
+)
+
+// The token request has its own retry policy, independent of Config.MaxAttempts and the retry backoff
+const (
+	tokenMaxAttempts = 3
+	tokenRetryDelay  = 200 * time.Millisecond
+)
+
+// tokenResponse is the OAuth token response. A field of an unexpected type fails decoding instead of a type assertion
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// tokenCall is an in-flight token request shared by all goroutines waiting for a token
+type tokenCall struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+func (c *client) getToken(ctx context.Context) (string, error) {
+	for {
+		c.tokenLock.Lock()
+		token := c.token
+		expiry := c.tokenExpiry
+
+		if c.isTokenValid(token, expiry) {
+			c.tokenLock.Unlock()
+			return token, nil
+		}
+
+		call := c.tokenCall
+		if call == nil {
+			call = &tokenCall{done: make(chan struct{})}
+			c.tokenCall = call
+			c.tokenLock.Unlock()
+
+			var fetchedExpiry time.Time
+			call.token, fetchedExpiry, call.err = c.loadToken(ctx)
+
+			c.tokenLock.Lock()
+			c.tokenCall = nil
+			if call.err == nil {
+				c.token = call.token
+				c.tokenExpiry = fetchedExpiry
+			}
+			c.tokenLock.Unlock()
+			close(call.done)
+
+			return call.token, call.err
+		}
+		c.tokenLock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("POST /oauth/access_token: %w", ctx.Err())
+		case <-call.done:
+		}
+
+		// The goroutine which made the request was canceled, but this one may still go on
+		if call.err != nil && (errors.Is(call.err, context.Canceled) || errors.Is(call.err, context.DeadlineExceeded)) {
+			continue
+		}
+		return call.token, call.err
+	}
+}
+
+// isTokenValid reports whether the token isn't going to expire soon.
+// A zero expiry means SendPulse didn't tell us the token lifetime, so it is kept until a 401
+func (c *client) isTokenValid(token string, expiry time.Time) bool {
+	return token != "" && (expiry.IsZero() || time.Now().Add(c.config.TokenExpiryBuffer).Before(expiry))
+}
+
+// loadToken takes the token from the configured TokenStore or requests a new one and saves it there
+func (c *client) loadToken(ctx context.Context) (string, time.Time, error) {
+	store := c.config.TokenStore
+	if store != nil {
+		if token, expiry, ok := store.Get(); ok && c.isTokenValid(token, expiry) {
+			return token, expiry, nil
+		}
+	}
+
+	token, expiry, err := c.fetchToken(ctx)
+	if err != nil {
+		return "", time.Time{}, err
 	}
 
+	if store != nil {
+		store.Set(token, expiry)
+	}
+	return token, expiry, nil
+}
+
+func (c *client) fetchToken(ctx context.Context) (string, time.Time, error) {
 	data := make(map[string]interface{})
 	data["grant_type"] = "client_credentials"
 	data["client_id"] = c.config.UserID
 	data["client_secret"] = c.config.Secret
 	path := "/oauth/access_token"
 
-	body, err := c.makeRequest(path, "POST", data, false)
+	body, err := c.requestToken(ctx, path, data)
 
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
 	}
 
-	var respData map[string]interface{}
+	var respData tokenResponse
 	if err := json.Unmarshal(body, &respData); err != nil {
-		return "", &SendpulseError{http.StatusOK, fmt.Sprintf(apiBaseUrl+"%s", path), string(body), err.Error()}
+		return "", time.Time{}, c.invalidResponse("POST", path, body, err.Error())
 	}
 
-	accessToken, tokenExists := respData["access_token"]
-	if !tokenExists {
-		return "", &SendpulseError{http.StatusOK, fmt.Sprintf(apiBaseUrl+"%s", path), string(body), "'access_token' not found in response"}
+	if respData.AccessToken == "" {
+		return "", time.Time{}, c.invalidResponse("POST", path, body, "'access_token' not found in response")
 	}
-	accessTokenStr := accessToken.(string)
 
-	c.tokenLock.Lock()
-	c.token = accessTokenStr
-	token = accessTokenStr
-	c.tokenLock.Unlock()
+	var expiry time.Time
+	if respData.ExpiresIn > 0 {
+		expiry = time.Now().Add(time.Duration(respData.ExpiresIn) * time.Second)
+	}
 
-	return token, nil
+	return respData.AccessToken, expiry, nil
 }
 
 func (c *client) clearToken() {
 	c.tokenLock.Lock()
+	token := c.token
 	c.token = ""
+	c.tokenExpiry = time.Time{}
 	c.tokenLock.Unlock()
+
+	// Another process may have already saved a new token, which must not be lost
+	if store := c.config.TokenStore; store != nil {
+		if storedToken, _, ok := store.Get(); ok && storedToken == token {
+			store.Set("", time.Time{})
+		}
+	}
 }
 
-func (c *client) makeRequest(path string, method string, data map[string]interface{}, useToken bool) ([]byte, error) {
+func (c *client) makeRequest(ctx context.Context, path string, method string, data map[string]interface{}, useToken bool) ([]byte, error) {
 	q := url.Values{}
 	for param, value := range data {
 		q.Add(param, fmt.Sprintf("%v", value))
 	}
 
-	method = strings.ToUpper(method)
+	body := requestBody{"application/x-www-form-urlencoded; param=value", []byte(q.Encode())}
+	return c.request(ctx, path, method, body, useToken, true)
+}
 
-	fullPath := apiBaseUrl + path
-	req, e := http.NewRequest(method, fullPath, bytes.NewBufferString(q.Encode()))
-	if e != nil {
-		return nil, e
+// makeJSONRequest sends an authorized request with the payload encoded as JSON, for endpoints expecting arrays or nested objects
+func (c *client) makeJSONRequest(ctx context.Context, path string, method string, payload interface{}) ([]byte, error) {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode request body: %w", err)
 	}
 
-	if method == "GET" {
-		req.URL.RawQuery = q.Encode()
-		req.Body = nil
-	} else {
-		req.Header.Set("Content-Type", "application/x-www-form-urlencoded; param=value")
+	body := requestBody{"application/json", encoded}
+	return c.request(ctx, path, method, body, true, true)
+}
+
+// makeMultipartRequest sends an authorized multipart/form-data request with the fields and a single file
+func (c *client) makeMultipartRequest(ctx context.Context, path string, fields map[string]string, fileField string, filename string, data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	for name, value := range fields {
+		if err := writer.WriteField(name, value); err != nil {
+			return nil, fmt.Errorf("could not encode request body: %w", err)
+		}
+	}
+
+	part, err := writer.CreateFormFile(fileField, filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode request body: %w", err)
+	}
+	if _, err := part.Write(data); err != nil {
+		return nil, fmt.Errorf("could not encode request body: %w", err)
 	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("could not encode request body: %w", err)
+	}
+
+	body := requestBody{writer.FormDataContentType(), buf.Bytes()}
+	return c.request(ctx, path, "POST", body, true, true)
+}
 
-	client := &http.Client{
-		Timeout: time.Duration(c.config.Timeout) * time.Second,
+// requestBody is an encoded request payload. It's kept as bytes to be read again on every retry
+type requestBody struct {
+	contentType string
+	data        []byte
+}
+
+// request sends an API request; if reauth is set (and not disabled by the config), the token is refreshed
+// and the request is repeated once on 401
+func (c *client) request(ctx context.Context, path string, method string, body requestBody, useToken bool, reauth bool) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("%s %s: %w", method, path, err)
 	}
 
+	method = strings.ToUpper(method)
+
+	var token string
 	if useToken {
-		token, err := c.getToken()
+		var err error
+		token, err = c.getToken(ctx)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	var resp *http.Response
+	var start time.Time
+	var route string
+	if c.config.Observer != nil {
+		route = requestRoute(ctx, path)
 	}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, &SendpulseError{http.StatusServiceUnavailable, path, "", err.Error()}
+	for attempt := 1; ; attempt++ {
+		if c.config.RateLimiter != nil {
+			if err := c.config.RateLimiter.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("%s %s: %w", method, path, err)
+			}
+		}
+
+		if c.config.Observer != nil {
+			start = time.Now()
+		}
+
+		var err error
+		// The request body is a consumed reader, so the request is rebuilt for every attempt
+		resp, err = c.sendRequest(ctx, path, method, body, token)
+		if err != nil && ctx.Err() != nil {
+			ctxErr := fmt.Errorf("%s %s: %w", method, path, ctx.Err())
+			c.observe(method, route, start, 0, ctxErr)
+			return nil, ctxErr
+		}
+
+		if attempt >= c.config.MaxAttempts || !c.isRetryable(resp, err) {
+			if err != nil {
+				spErr := &SendpulseError{HttpCode: http.StatusServiceUnavailable, Method: method, Url: c.url(path), Body: "", Message: err.Error()}
+				c.observe(method, route, start, 0, spErr)
+				return nil, spErr
+			}
+			break
+		}
+
+		if c.config.Observer != nil {
+			if resp != nil {
+				c.observe(method, route, start, resp.StatusCode, newResponseError(resp.StatusCode, method, c.url(path), nil))
+			} else {
+				c.observe(method, route, start, 0, err)
+			}
+		}
+
+		delay := c.retryBackoff(attempt)
+		if resp != nil {
+			if c.config.Logger != nil {
+				c.logResponse(method, path, resp.StatusCode, nil)
+			}
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok && resp.StatusCode == http.StatusTooManyRequests {
+				delay = retryAfter
+			}
+			resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%s %s: %w", method, path, ctx.Err())
+		case <-time.After(delay):
+		}
 	}
 
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusUnauthorized && useToken {
+	if resp.StatusCode == http.StatusUnauthorized && useToken && reauth && !c.config.DisableAutoReauth {
+		if c.config.Observer != nil {
+			c.observe(method, route, start, resp.StatusCode, newResponseError(resp.StatusCode, method, c.url(path), nil))
+		}
+		if c.config.Logger != nil {
+			c.logResponse(method, path, resp.StatusCode, nil)
+		}
 		c.clearToken()
 
-		respData, err := c.makeRequest(path, method, data, useToken)
+		respData, err := c.request(ctx, path, method, body, useToken, false)
 		if err != nil {
 			return nil, err
 		}
 		return respData, nil
 	}
 
-	body, err := ioutil.ReadAll(resp.Body)
+	respData, err := c.readResponse(method, path, resp)
+	c.observe(method, route, start, resp.StatusCode, err)
+	return respData, err
+}
+
+// requestToken sends the token request. Only network errors are retried, a fixed number of times:
+// the general retry settings don't apply, and a 401 is returned as is instead of triggering the token refresh
+func (c *client) requestToken(ctx context.Context, path string, data map[string]interface{}) ([]byte, error) {
+	q := url.Values{}
+	for param, value := range data {
+		q.Add(param, fmt.Sprintf("%v", value))
+	}
+	body := requestBody{"application/x-www-form-urlencoded; param=value", []byte(q.Encode())}
+
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("POST %s: %w", path, err)
+		}
+		if c.config.RateLimiter != nil {
+			if err := c.config.RateLimiter.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("POST %s: %w", path, err)
+			}
+		}
+
+		var start time.Time
+		if c.config.Observer != nil {
+			start = time.Now()
+		}
+
+		resp, err := c.sendRequest(ctx, path, "POST", body, "")
+		if err == nil {
+			defer resp.Body.Close()
+			respData, err := c.readResponse("POST", path, resp)
+			c.observe("POST", path, start, resp.StatusCode, err)
+			return respData, err
+		}
+		if ctx.Err() != nil {
+			ctxErr := fmt.Errorf("POST %s: %w", path, ctx.Err())
+			c.observe("POST", path, start, 0, ctxErr)
+			return nil, ctxErr
+		}
+		if attempt >= tokenMaxAttempts {
+			spErr := &SendpulseError{HttpCode: http.StatusServiceUnavailable, Method: "POST", Url: c.url(path), Body: "", Message: err.Error()}
+			c.observe("POST", path, start, 0, spErr)
+			return nil, spErr
+		}
+		c.observe("POST", path, start, 0, err)
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("POST %s: %w", path, ctx.Err())
+		case <-time.After(tokenRetryDelay):
+		}
+	}
+}
+
+// readResponse reads the response body and turns a non-2xx status into *SendpulseError
+func (c *client) readResponse(method string, path string, resp *http.Response) ([]byte, error) {
+	respBody, err := readBody(resp)
+
+	if c.config.Logger != nil {
+		c.logResponse(method, path, resp.StatusCode, respBody)
+	}
+
+	if err != nil {
+		return nil, &SendpulseError{HttpCode: resp.StatusCode, Method: method, Url: c.url(path), Body: string(respBody), Message: err.Error()}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		spErr := newResponseError(resp.StatusCode, method, c.url(path), respBody)
+		if resp.StatusCode == http.StatusTooManyRequests {
+			spErr.RetryAfter = defaultRetryAfter
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				spErr.RetryAfter = retryAfter
+			}
+		}
+		return nil, spErr
+	}
+
+	return respBody, nil
+}
+
+// url returns the full URL of the API path
+func (c *client) url(path string) string {
+	return c.config.BaseURL + path
+}
+
+// invalidResponse builds an error for a successful response SendPulse sent in an unexpected format
+func (c *client) invalidResponse(method string, path string, body []byte, message string) *SendpulseError {
+	return &SendpulseError{HttpCode: http.StatusOK, Method: method, Url: c.url(path), Body: string(body), Message: message}
+}
+
+// checkResult validates the {"result": true} body returned by write operations.
+// An empty body, e.g. of 204 No Content, and a bare true or 1 mean success too
+func (c *client) checkResult(method string, path string, body []byte) error {
+	success, err := parseBoolResult(body)
+	if err != nil {
+		return c.invalidResponse(method, path, body, err.Error())
+	}
+	if !success {
+		return c.invalidResponse(method, path, body, "invalid response")
+	}
+	return nil
+}
+
+// parseBoolResult reads the outcome of a write operation from a bare true/false/1/0 body or from
+// the "result" property of a JSON object. An empty body means success
+func parseBoolResult(body []byte) (bool, error) {
+	trimmed := bytes.TrimSpace(body)
+	switch string(trimmed) {
+	case "", "true", "1":
+		return true, nil
+	case "false", "0":
+		return false, nil
+	}
+
+	var respData map[string]interface{}
+	if err := json.Unmarshal(trimmed, &respData); err != nil {
+		return false, err
+	}
 
+	switch result := respData["result"].(type) {
+	case bool:
+		return result, nil
+	case float64:
+		if result == 0 || result == 1 {
+			return result == 1, nil
+		}
+	}
+	return false, errors.New("invalid response")
+}
+
+func (c *client) sendRequest(ctx context.Context, path string, method string, body requestBody, token string) (*http.Response, error) {
+	var req *http.Request
+	var err error
+	if method == "GET" {
+		// Parameters go to the query; the request is built without a body, so no Content-Length or Content-Type is sent
+		fullURL := c.url(path)
+		if len(body.data) != 0 {
+			fullURL += "?" + string(body.data)
+		}
+		req, err = http.NewRequestWithContext(ctx, method, fullURL, nil)
+	} else {
+		req, err = http.NewRequestWithContext(ctx, method, c.url(path), bytes.NewReader(body.data))
+	}
 	if err != nil {
-		return nil, &SendpulseError{resp.StatusCode, path, string(body), err.Error()}
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, &SendpulseError{resp.StatusCode, path, string(body), ""}
+	c.setExtraHeaders(ctx, req)
+
+	if method != "GET" {
+		req.Header.Set("Content-Type", body.contentType)
 	}
 
-	return body, nil
+	req.Header.Set("User-Agent", c.config.UserAgent)
+	// Set explicitly, the transport leaves decompression to readBody
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	if token != "" {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	}
+
+	if c.config.Logger != nil {
+		c.logRequest(req, body)
+	}
+
+	return c.httpClient.Do(req)
+}
+
+// readBody reads the response body decompressing it if needed
+func readBody(resp *http.Response) ([]byte, error) {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return ioutil.ReadAll(resp.Body)
+	}
+
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+// isRetryable reports whether a request failed transiently: a network error or a 5xx gateway/server error.
+// 429 is retried only if RetryRateLimited is enabled
+func (c *client) isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	case http.StatusTooManyRequests:
+		return c.config.RetryRateLimited
+	}
+	return false
+}
+
+// retryBackoff returns the exponential delay before the next attempt with a random jitter of up to a half of it
+func (c *client) retryBackoff(attempt int) time.Duration {
+	backoff := c.config.RetryBackoffBase << uint(attempt-1)
+	if backoff <= 0 || backoff > c.config.RetryBackoffMax {
+		backoff = c.config.RetryBackoffMax
+	}
+
+	half := int64(backoff / 2)
+	if half == 0 {
+		return backoff
+	}
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// toInt converts a loosely typed JSON value (an integer, a float or a numeric string) to int.
+// fmt.Sprint can't be used for floats as it formats big numbers in exponent notation
+func toInt(value interface{}) int {
+	switch v := value.(type) {
+	case float64:
+		return int(v)
+	case json.Number:
+		n, _ := v.Int64()
+		return int(n)
+	}
+	n, _ := strconv.Atoi(fmt.Sprint(value))
+	return n
+}
+
+// sendDateLayout is the "Y-m-d H:i:s" format SendPulse uses for dates, always in UTC
+const sendDateLayout = "2006-01-02 15:04:05"
+
+// formatSendDate converts a scheduling time in any location to the UTC string expected by the API.
+// It's used for the send dates of campaigns, SMS, Viber and push; callers omit the parameter for the zero time
+func formatSendDate(t time.Time) string {
+	return t.UTC().Format(sendDateLayout)
+}
+
+// isEmptyCollection reports whether body is an empty JSON array or object.
+// The API isn't consistent about them and may return [] where an object is expected and vice versa
+func isEmptyCollection(body []byte) bool {
+	trimmed := strings.Join(strings.Fields(string(body)), "")
+	return trimmed == "[]" || trimmed == "{}"
+}
+
+// toFloat converts a loosely typed JSON value (a number or a numeric string) to float64
+func toFloat(value interface{}) float64 {
+	if v, ok := value.(float64); ok {
+		return v
+	}
+	n, _ := strconv.ParseFloat(strings.TrimSpace(fmt.Sprint(value)), 64)
+	return n
+}
+
+// toString converts a loosely typed JSON value (a string or a number) to string. Numbers are formatted without exponent
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
 }
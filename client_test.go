@@ -1,17 +1,24 @@
 package sendpulse
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
 	"fmt"
 	"github.com/icrowley/fake"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
 	"net/http"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestSendpulseError_Error(t *testing.T) {
-	e := SendpulseError{http.StatusInternalServerError, "http://test.com", "Something went wrong", "Test message"}
-	assert.Equal(t, fmt.Sprintf("Http code: %d, url: %s, body: %s, message: %s", e.HttpCode, e.Url, e.Body, e.Message), e.Error())
+	e := SendpulseError{HttpCode: http.StatusInternalServerError, Method: "DELETE", Url: "http://test.com", Body: "Something went wrong", Message: "Test message"}
+	assert.Equal(t, fmt.Sprintf("Http code: %d, method: %s, url: %s, body: %s, message: %s", e.HttpCode, e.Method, e.Url, e.Body, e.Message), e.Error())
 }
 
 func TestClient_ClearToken(t *testing.T) {
@@ -25,12 +32,12 @@ func TestClient_ClearToken(t *testing.T) {
 	token := fake.Word()
 	c.token = token
 
-	tok, _ := c.getToken()
+	tok, _ := c.getToken(context.Background())
 	assert.Equal(t, token, tok)
 
 	c.clearToken()
 
-	emptyTok, _ := c.getToken()
+	emptyTok, _ := c.getToken(context.Background())
 	assert.Equal(t, "", emptyTok)
 }
 
@@ -43,7 +50,7 @@ func TestClient_GetToken_Stored(t *testing.T) {
 	token := fake.Word()
 	c := NewClient(config)
 	c.token = token
-	result, err := c.getToken()
+	result, err := c.getToken(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, token, result)
 }
@@ -63,7 +70,7 @@ func TestClient_GetToken_Error(t *testing.T) {
 	}
 
 	c := NewClient(config)
-	token, err := c.getToken()
+	token, err := c.getToken(context.Background())
 	assert.Error(t, err)
 	assert.Equal(t, "", token)
 }
@@ -83,7 +90,7 @@ func TestClient_GetToken_BadJson(t *testing.T) {
 	}
 
 	c := NewClient(config)
-	token, err := c.getToken()
+	token, err := c.getToken(context.Background())
 	assert.Error(t, err)
 	assert.Equal(t, "", token)
 }
@@ -103,7 +110,7 @@ func TestClient_GetToken_NoTokenProperty(t *testing.T) {
 	}
 
 	c := NewClient(config)
-	token, err := c.getToken()
+	token, err := c.getToken(context.Background())
 	assert.Error(t, err)
 	assert.Equal(t, "", token)
 }
@@ -124,7 +131,839 @@ func TestClient_GetToken_Success(t *testing.T) {
 	}
 
 	c := NewClient(config)
-	newToken, err := c.getToken()
+	newToken, err := c.getToken(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, token, newToken)
 }
+
+func TestClient_MakeRequest_Canceled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	config := Config{
+		UserID:  fake.Word(),
+		Secret:  fake.Word(),
+		Timeout: 5,
+	}
+
+	c := NewClient(config)
+	c.token = fake.Word()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	body, err := c.makeRequest(ctx, "/addressbooks", "GET", nil, true)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Nil(t, body)
+}
+
+func TestClient_GetToken_Canceled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusOK,
+			`{"access_token": "testtoken","token_type": "Bearer","expires_in": 3600}`))
+
+	config := Config{
+		UserID:  fake.Word(),
+		Secret:  fake.Word(),
+		Timeout: 5,
+	}
+
+	c := NewClient(config)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	token, err := c.getToken(ctx)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, "", token)
+}
+
+func TestClient_GetToken_Expired(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	token := fake.Word()
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusOK,
+			`{"access_token": "`+token+`","token_type": "Bearer","expires_in": 3600}`))
+
+	config := Config{
+		UserID:  fake.Word(),
+		Secret:  fake.Word(),
+		Timeout: 0,
+	}
+
+	c := NewClient(config)
+	c.token = fake.Word()
+	c.tokenExpiry = time.Now().Add(30 * time.Second)
+
+	newToken, err := c.getToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, token, newToken)
+	assert.True(t, c.tokenExpiry.After(time.Now().Add(59*time.Minute)))
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	cachedToken, err := c.getToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, token, cachedToken)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestClient_GetToken_ExpiryBuffer(t *testing.T) {
+	config := Config{
+		UserID:            fake.Word(),
+		Secret:            fake.Word(),
+		Timeout:           0,
+		TokenExpiryBuffer: 10 * time.Second,
+	}
+
+	token := fake.Word()
+	c := NewClient(config)
+	c.token = token
+	c.tokenExpiry = time.Now().Add(30 * time.Second)
+
+	result, err := c.getToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, token, result)
+}
+
+func TestNewClient_DefaultHTTPClient(t *testing.T) {
+	config := Config{
+		UserID:  fake.Word(),
+		Secret:  fake.Word(),
+		Timeout: 7,
+	}
+
+	c := NewClient(config)
+	assert.NotNil(t, c.httpClient)
+	assert.Equal(t, 7*time.Second, c.httpClient.Timeout)
+}
+
+func TestClient_MakeRequest_CustomHTTPClient(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	token := fake.Word()
+	transport.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusOK,
+			`{"access_token": "`+token+`","token_type": "Bearer","expires_in": 3600}`))
+	transport.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	httpClient := &http.Client{Transport: transport}
+	config := Config{
+		UserID:     fake.Word(),
+		Secret:     fake.Word(),
+		Timeout:    5,
+		HTTPClient: httpClient,
+	}
+
+	c := NewClient(config)
+	assert.Equal(t, httpClient, c.httpClient)
+
+	body, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(body))
+	assert.Equal(t, 2, transport.GetTotalCallCount())
+}
+
+func TestClient_MakeRequest_RetryServerError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var bodies []string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/addressbooks",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			bodies = append(bodies, string(body))
+			if len(bodies) < 3 {
+				return httpmock.NewStringResponse(http.StatusBadGateway, ""), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"id": 1}`), nil
+		})
+
+	config := Config{
+		UserID:           fake.Word(),
+		Secret:           fake.Word(),
+		Timeout:          5,
+		RetryBackoffBase: time.Millisecond,
+	}
+
+	c := NewClient(config)
+	c.token = fake.Word()
+
+	body, err := c.makeRequest(context.Background(), "/addressbooks", "POST", map[string]interface{}{"bookName": "test"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id": 1}`, string(body))
+	assert.Equal(t, []string{"bookName=test", "bookName=test", "bookName=test"}, bodies)
+}
+
+func TestClient_MakeRequest_RetryExhausted(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusServiceUnavailable, `Unavailable`))
+
+	config := Config{
+		UserID:           fake.Word(),
+		Secret:           fake.Word(),
+		Timeout:          5,
+		MaxAttempts:      2,
+		RetryBackoffBase: time.Millisecond,
+	}
+
+	c := NewClient(config)
+	c.token = fake.Word()
+
+	_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	assert.Error(t, err)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, http.StatusServiceUnavailable, spErr.HttpCode)
+	assert.Equal(t, `Unavailable`, spErr.Body)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestClient_MakeRequest_RetryNetworkError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		httpmock.NewErrorResponder(errors.New("connection reset by peer")))
+
+	config := Config{
+		UserID:           fake.Word(),
+		Secret:           fake.Word(),
+		Timeout:          5,
+		RetryBackoffBase: time.Millisecond,
+	}
+
+	c := NewClient(config)
+	c.token = fake.Word()
+
+	_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	assert.Error(t, err)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, http.StatusServiceUnavailable, spErr.HttpCode)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestClient_MakeRequest_NoRetryClientError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusBadRequest, `Bad request`))
+
+	config := Config{
+		UserID:  fake.Word(),
+		Secret:  fake.Word(),
+		Timeout: 5,
+	}
+
+	c := NewClient(config)
+	c.token = fake.Word()
+
+	_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	assert.Error(t, err)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestClient_RetryBackoff(t *testing.T) {
+	config := Config{
+		UserID:           fake.Word(),
+		Secret:           fake.Word(),
+		RetryBackoffBase: 100 * time.Millisecond,
+		RetryBackoffMax:  300 * time.Millisecond,
+	}
+
+	c := NewClient(config)
+	for attempt, limit := range map[int]time.Duration{1: 100 * time.Millisecond, 2: 200 * time.Millisecond, 3: 300 * time.Millisecond, 10: 300 * time.Millisecond} {
+		backoff := c.retryBackoff(attempt)
+		assert.True(t, backoff >= limit/2 && backoff <= limit)
+	}
+}
+
+func TestClient_GetToken_Concurrent(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	token := fake.Word()
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		func(req *http.Request) (*http.Response, error) {
+			time.Sleep(50 * time.Millisecond)
+			return httpmock.NewStringResponse(http.StatusOK,
+				`{"access_token": "`+token+`","token_type": "Bearer","expires_in": 3600}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.Word(),
+		Secret:  fake.Word(),
+		Timeout: 5,
+	}
+
+	c := NewClient(config)
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 20)
+	errs := make([]error, 20)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tokens[i], errs[i] = c.getToken(context.Background())
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range tokens {
+		assert.NoError(t, errs[i])
+		assert.Equal(t, token, tokens[i])
+	}
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestClient_GetToken_ConcurrentError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		func(req *http.Request) (*http.Response, error) {
+			time.Sleep(50 * time.Millisecond)
+			return httpmock.NewStringResponse(http.StatusBadRequest, `Bad credentials`), nil
+		})
+
+	config := Config{
+		UserID:  fake.Word(),
+		Secret:  fake.Word(),
+		Timeout: 5,
+	}
+
+	c := NewClient(config)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 10)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = c.getToken(context.Background())
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		assert.Error(t, err)
+	}
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestClient_MakeRequest_UnauthorizedOnce(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	token := fake.Word()
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusOK,
+			`{"access_token": "`+token+`","token_type": "Bearer","expires_in": 3600}`))
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Authorization") != "Bearer "+token {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, `Unauthorized`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `[]`), nil
+		})
+
+	config := Config{
+		UserID:  fake.Word(),
+		Secret:  fake.Word(),
+		Timeout: 5,
+	}
+
+	c := NewClient(config)
+	c.token = fake.Word()
+
+	body, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(body))
+	assert.Equal(t, token, c.token)
+}
+
+func TestClient_MakeRequest_UnauthorizedNoReauth(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusOK,
+			`{"access_token": "`+fake.Word()+`","token_type": "Bearer","expires_in": 3600}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusUnauthorized, `{"error_code": 401, "message": "Unauthorized"}`))
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5}, WithoutAutoReauth())
+	token := fake.Word()
+	c.token = token
+
+	_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	spErr, isSpErr := err.(*SendpulseError)
+	assert.True(t, isSpErr)
+	assert.Equal(t, http.StatusUnauthorized, spErr.HttpCode)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.Equal(t, token, c.token)
+}
+
+func TestClient_MakeRequest_UnauthorizedTwice(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusOK,
+			`{"access_token": "testtoken","token_type": "Bearer","expires_in": 3600}`))
+
+	respBody := `{"error_code": 401, "message": "Unauthorized"}`
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusUnauthorized, respBody))
+
+	config := Config{
+		UserID:  fake.Word(),
+		Secret:  fake.Word(),
+		Timeout: 5,
+	}
+
+	c := NewClient(config)
+	c.token = fake.Word()
+
+	_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	assert.Error(t, err)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, http.StatusUnauthorized, spErr.HttpCode)
+	assert.Equal(t, respBody, spErr.Body)
+	assert.True(t, IsAuthError(err))
+
+	callCounts := httpmock.GetCallCountInfo()
+	assert.Equal(t, 2, callCounts["GET "+apiBaseUrl+"/addressbooks"])
+	assert.Equal(t, 1, callCounts["POST "+apiBaseUrl+"/oauth/access_token"])
+}
+
+func TestIsAuthError(t *testing.T) {
+	assert.True(t, IsAuthError(&SendpulseError{HttpCode: http.StatusUnauthorized, Url: "/addressbooks", Body: "", Message: ""}))
+	assert.False(t, IsAuthError(&SendpulseError{HttpCode: http.StatusBadRequest, Url: "/addressbooks", Body: "", Message: ""}))
+	assert.False(t, IsAuthError(errors.New("something went wrong")))
+	assert.False(t, IsAuthError(nil))
+}
+
+func TestClient_MakeJSONRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var contentType string
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/addressbooks/1/emails",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			contentType = req.Header.Get("Content-Type")
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.Word(),
+		Secret:  fake.Word(),
+		Timeout: 5,
+	}
+
+	c := NewClient(config)
+	c.token = fake.Word()
+
+	payload := map[string]interface{}{
+		"emails": []Email{
+			{
+				Email:     "alice@example.com",
+				Variables: map[string]interface{}{"age": 30},
+			},
+		},
+	}
+	body, err := c.makeJSONRequest(context.Background(), "/addressbooks/1/emails", "POST", payload)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"result": true}`, string(body))
+	assert.Equal(t, "application/json", contentType)
+	assert.JSONEq(t, `{"emails": [{"email": "alice@example.com", "variables": {"age": 30}}]}`, requestBody)
+}
+
+func TestClient_MakeJSONRequest_BadPayload(t *testing.T) {
+	config := Config{
+		UserID:  fake.Word(),
+		Secret:  fake.Word(),
+		Timeout: 5,
+	}
+
+	c := NewClient(config)
+	c.token = fake.Word()
+
+	_, err := c.makeJSONRequest(context.Background(), "/addressbooks/1/emails", "POST", map[string]interface{}{"channel": make(chan int)})
+	assert.Error(t, err)
+}
+
+func TestClient_MakeRequest_BaseURL(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	baseURL := "https://mock.local"
+	httpmock.RegisterResponder("GET", baseURL+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	for _, configuredURL := range []string{baseURL, baseURL + "/"} {
+		config := Config{
+			UserID:  fake.Word(),
+			Secret:  fake.Word(),
+			Timeout: 5,
+			BaseURL: configuredURL,
+		}
+
+		c := NewClient(config)
+		c.token = fake.Word()
+
+		body, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+		assert.NoError(t, err)
+		assert.Equal(t, `[]`, string(body))
+	}
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestClient_MakeRequest_GetWithoutBody(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var captured *http.Request
+	var capturedBody []byte
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks?limit=10&offset=20",
+		func(req *http.Request) (*http.Response, error) {
+			captured = req
+			if req.Body != nil {
+				capturedBody, _ = ioutil.ReadAll(req.Body)
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `[]`), nil
+		})
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5})
+	c.token = fake.Word()
+
+	_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", map[string]interface{}{"limit": 10, "offset": 20}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "limit=10&offset=20", captured.URL.RawQuery)
+	assert.Equal(t, int64(0), captured.ContentLength)
+	assert.Empty(t, capturedBody)
+	assert.Equal(t, "", captured.Header.Get("Content-Type"))
+}
+
+func TestNewClient_DefaultBaseURL(t *testing.T) {
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word()})
+	assert.Equal(t, apiBaseUrl, c.config.BaseURL)
+}
+
+func TestClient_MakeRequest_UserAgent(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	userAgents := make(map[string]string)
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		func(req *http.Request) (*http.Response, error) {
+			userAgents[req.URL.Path] = req.Header.Get("User-Agent")
+			return httpmock.NewStringResponse(http.StatusOK,
+				`{"access_token": "testtoken","token_type": "Bearer","expires_in": 3600}`), nil
+		})
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		func(req *http.Request) (*http.Response, error) {
+			userAgents[req.URL.Path] = req.Header.Get("User-Agent")
+			return httpmock.NewStringResponse(http.StatusOK, `[]`), nil
+		})
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5})
+	_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "sendpulse-sdk-go/"+Version, userAgents["/oauth/access_token"])
+	assert.Equal(t, "sendpulse-sdk-go/"+Version, userAgents["/addressbooks"])
+
+	c = NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5, UserAgent: "my-service/2.0"})
+	_, err = c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-service/2.0", userAgents["/oauth/access_token"])
+	assert.Equal(t, "my-service/2.0", userAgents["/addressbooks"])
+}
+
+func TestClient_MakeRequest_ErrorBody(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	respBody := `{"is_error": true, "error_code": 203, "message": "Book name already in use"}`
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusBadRequest, respBody))
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5})
+	c.token = fake.Word()
+
+	_, err := c.makeRequest(context.Background(), "/addressbooks", "POST", map[string]interface{}{"bookName": "test"}, true)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, http.StatusBadRequest, spErr.HttpCode)
+	assert.Equal(t, 203, spErr.ErrorCode)
+	assert.Equal(t, "Book name already in use", spErr.ErrorDescription)
+	assert.Equal(t, respBody, spErr.Body)
+	assert.True(t, spErr.IsValidationError())
+	assert.False(t, spErr.IsRateLimited())
+}
+
+func TestClient_MakeRequest_ErrorBodyNotJson(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusTooManyRequests, `Too many requests`))
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5})
+	c.token = fake.Word()
+
+	_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, 0, spErr.ErrorCode)
+	assert.Equal(t, "", spErr.ErrorDescription)
+	assert.Equal(t, `Too many requests`, spErr.Body)
+	assert.True(t, spErr.IsRateLimited())
+	assert.False(t, spErr.IsValidationError())
+}
+
+func TestClient_GetToken_ErrorBody(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusBadRequest,
+			`{"error": "invalid_client", "error_description": "Client authentication failed"}`))
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5})
+
+	_, err := c.getToken(context.Background())
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, "Client authentication failed", spErr.ErrorDescription)
+}
+
+func TestClient_MakeRequest_Gzip(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	writer.Write([]byte(`{"sent": 100}`))
+	writer.Close()
+
+	var acceptEncoding string
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/1",
+		func(req *http.Request) (*http.Response, error) {
+			acceptEncoding = req.Header.Get("Accept-Encoding")
+			resp := httpmock.NewBytesResponse(http.StatusOK, compressed.Bytes())
+			resp.Header.Set("Content-Encoding", "gzip")
+			return resp, nil
+		})
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5})
+	c.token = fake.Word()
+
+	body, err := c.makeRequest(context.Background(), "/campaigns/1", "GET", nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"sent": 100}`, string(body))
+	assert.Equal(t, "gzip", acceptEncoding)
+}
+
+func TestClient_MakeRequest_GzipBroken(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/1",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusOK, `{"sent": 100}`)
+			resp.Header.Set("Content-Encoding", "gzip")
+			return resp, nil
+		})
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5})
+	c.token = fake.Word()
+
+	_, err := c.makeRequest(context.Background(), "/campaigns/1", "GET", nil, true)
+	assert.Error(t, err)
+	_, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+}
+
+func TestClient_MakeRequest_Created(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusCreated, `{"id": 1}`))
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5})
+	c.token = fake.Word()
+
+	body, err := c.makeRequest(context.Background(), "/addressbooks", "POST", map[string]interface{}{"bookName": "test"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id": 1}`, string(body))
+}
+
+func TestCheckResult(t *testing.T) {
+	c := NewClient(Config{})
+	assert.NoError(t, c.checkResult("PUT", "/addressbooks/1", []byte(`{"result": true}`)))
+	assert.NoError(t, c.checkResult("PUT", "/addressbooks/1", []byte("")))
+	assert.NoError(t, c.checkResult("PUT", "/addressbooks/1", []byte("true")))
+	assert.Error(t, c.checkResult("PUT", "/addressbooks/1", []byte("false")))
+	assert.Error(t, c.checkResult("PUT", "/addressbooks/1", []byte(`{"result": false}`)))
+	assert.Error(t, c.checkResult("PUT", "/addressbooks/1", []byte(`{"result": "yes"}`)))
+	assert.Error(t, c.checkResult("PUT", "/addressbooks/1", []byte(`{"id": 1}`)))
+	assert.Error(t, c.checkResult("PUT", "/addressbooks/1", []byte(`Invalid json`)))
+
+	err := c.checkResult("PUT", "/addressbooks/1", []byte(`{"result": false}`))
+	spErr, isSpErr := err.(*SendpulseError)
+	assert.True(t, isSpErr)
+	assert.Equal(t, "PUT", spErr.Method)
+	assert.Equal(t, apiBaseUrl+"/addressbooks/1", spErr.Url)
+}
+
+func TestParseBoolResult(t *testing.T) {
+	for body, expected := range map[string]bool{
+		"true":                    true,
+		" 1\n":                    true,
+		"":                        true,
+		"false":                   false,
+		"0":                       false,
+		`{"result": true}`:        true,
+		`{"result": false}`:       false,
+		`{"result": 1, "id": 12}`: true,
+	} {
+		result, err := parseBoolResult([]byte(body))
+		assert.NoError(t, err, body)
+		assert.Equal(t, expected, result, body)
+	}
+
+	for _, body := range []string{`{"id": 1}`, `{"result": "yes"}`, `[true]`, `OK`} {
+		_, err := parseBoolResult([]byte(body))
+		assert.Error(t, err, body)
+	}
+}
+
+func TestClient_GetToken_UnauthorizedNoRecursion(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	respBody := `{"error":"invalid_client","error_description":"Client authentication failed.","message":"Client authentication failed."}`
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusUnauthorized, respBody))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	config := Config{
+		UserID:      fake.Word(),
+		Secret:      fake.Word(),
+		Timeout:     5,
+		MaxAttempts: 5,
+	}
+
+	c := NewClient(config)
+
+	_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	assert.Error(t, err)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, http.StatusUnauthorized, spErr.HttpCode)
+	assert.Equal(t, "POST", spErr.Method)
+	assert.Equal(t, apiBaseUrl+"/oauth/access_token", spErr.Url)
+	assert.True(t, IsAuthError(err))
+
+	callCounts := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, callCounts["POST "+apiBaseUrl+"/oauth/access_token"])
+	assert.Equal(t, 0, callCounts["GET "+apiBaseUrl+"/addressbooks"])
+}
+
+func TestClient_GetToken_ServerErrorNotRetried(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusInternalServerError, `{"message": "Internal error"}`))
+
+	config := Config{
+		UserID:      fake.Word(),
+		Secret:      fake.Word(),
+		Timeout:     5,
+		MaxAttempts: 5,
+	}
+
+	c := NewClient(config)
+
+	_, err := c.getToken(context.Background())
+	assert.Error(t, err)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, http.StatusInternalServerError, spErr.HttpCode)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["POST "+apiBaseUrl+"/oauth/access_token"])
+}
+
+func TestClient_GetToken_NetworkErrorRetried(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewErrorResponder(errors.New("connection reset by peer")))
+
+	config := Config{
+		UserID:      fake.Word(),
+		Secret:      fake.Word(),
+		Timeout:     5,
+		MaxAttempts: 1,
+	}
+
+	c := NewClient(config)
+
+	_, err := c.getToken(context.Background())
+	assert.Error(t, err)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, http.StatusServiceUnavailable, spErr.HttpCode)
+	assert.Equal(t, tokenMaxAttempts, httpmock.GetCallCountInfo()["POST "+apiBaseUrl+"/oauth/access_token"])
+}
+
+func TestFormatSendDate(t *testing.T) {
+	assert.Equal(t, "2030-01-02 10:30:00", formatSendDate(time.Date(2030, 1, 2, 10, 30, 0, 0, time.UTC)))
+
+	location := time.FixedZone("UTC-5", -5*60*60)
+	assert.Equal(t, "2030-01-02 15:30:00", formatSendDate(time.Date(2030, 1, 2, 10, 30, 0, 0, location)))
+
+	local := time.Date(2030, 1, 2, 10, 30, 0, 0, time.Local)
+	assert.Equal(t, local.UTC().Format("2006-01-02 15:04:05"), formatSendDate(local))
+}
+
+func TestClient_GetToken_AccessTokenNotString(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusOK, `{"access_token": 12345, "token_type": "Bearer", "expires_in": 3600}`))
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5})
+
+	assert.NotPanics(t, func() {
+		_, err := c.getToken(context.Background())
+		assert.Error(t, err)
+		spErr, isSpError := err.(*SendpulseError)
+		assert.True(t, isSpError)
+		assert.Equal(t, apiBaseUrl+"/oauth/access_token", spErr.Url)
+	})
+}
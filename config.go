@@ -1,7 +1,43 @@
 package sendpulse
 
+import (
+	"net/http"
+	"time"
+)
+
 type Config struct {
 	UserID  string
 	Secret  string
 	Timeout int
+	// BaseURL overrides the SendPulse API address, e.g. to use a mock server in tests
+	BaseURL string
+	// UserAgent overrides the default "sendpulse-sdk-go/<version>" User-Agent header
+	UserAgent string
+	// TokenExpiryBuffer is how long before its expiry the access token is refreshed (60 seconds by default)
+	TokenExpiryBuffer time.Duration
+	// TokenStore, if set, shares the access token between clients and processes. Otherwise it's kept by the client only
+	TokenStore TokenStore
+	// HTTPClient is used for all requests if set (e.g. to configure a proxy or TLS); Timeout is ignored then
+	HTTPClient *http.Client
+	// MaxAttempts limits how many times a request is sent on network errors and 500/502/503/504 responses (3 by default)
+	MaxAttempts int
+	// RetryBackoffBase is the delay before the first retry, doubled for every next one (100ms by default)
+	RetryBackoffBase time.Duration
+	// RetryBackoffMax caps the delay between retries (2 seconds by default)
+	RetryBackoffMax time.Duration
+	// RetryRateLimited enables retrying 429 responses after the delay from the Retry-After header
+	RetryRateLimited bool
+	// DisableAutoReauth makes a 401 response be returned as is, keeping the token, instead of refreshing the token
+	// and repeating the request once
+	DisableAutoReauth bool
+	// RateLimiter, if set, is waited for before every request, e.g. a *rate.Limiter from golang.org/x/time/rate
+	RateLimiter RateLimiter
+	// DefaultHeaders are added to every request. Authorization, Content-Type and User-Agent are ignored
+	DefaultHeaders http.Header
+	// Observer, if set, receives the method, route, status, duration and error of every request attempt
+	Observer Observer
+	// Logger, if set, receives every request and response for debugging
+	Logger Logger
+	// RedactedHeaders are logged as [REDACTED] in addition to the credential headers, see isRedactedHeader
+	RedactedHeaders []string
 }
@@ -1,7 +1,16 @@
 package sendpulse
 
+import "errors"
+
+// ErrEmptyEmails is returned without calling the API when an operation gets no emails
+var ErrEmptyEmails = errors.New("emails list is empty")
+
 type Emails struct {
 	Books         books
 	Automation360 automation360
 	Campaigns     campaigns
+	Blacklist     blacklist
+	Senders       senders
+	Templates     templates
+	Verifier      verifier
 }
@@ -1,40 +1,300 @@
 package sendpulse
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
+	"net/url"
+	"time"
 )
 
 type automation360 struct {
 	Client *client
 }
 
+// ErrNoEventIdentifier is returned without calling the API when an event has neither an email nor a phone
+var ErrNoEventIdentifier = errors.New("email and phone are empty")
+
 func (a *automation360) StartEvent(eventName string, variables map[string]interface{}) error {
-	path := fmt.Sprintf("/events/name/%s", eventName)
+	return a.StartEventContext(context.Background(), eventName, variables)
+}
+
+func (a *automation360) StartEventContext(ctx context.Context, eventName string, variables map[string]interface{}) error {
+	path := fmt.Sprintf("/events/name/%s", url.PathEscape(eventName))
+	ctx = withRoute(ctx, "/events/name/{name}")
 
 	_, emailExists := variables["email"]
 	_, phoneExists := variables["phone"]
 
 	if !emailExists && !phoneExists {
-		return errors.New("email and phone are empty")
+		return ErrNoEventIdentifier
+	}
+
+	body, err := a.Client.makeRequest(ctx, path, "POST", variables, true)
+	if err != nil {
+		return err
+	}
+
+	return a.Client.checkResult("POST", path, body)
+}
+
+// SendEvent starts the event flows for the subscriber identified by email or phone (at least one of them is required).
+// Unlike StartEvent the variables are sent as JSON, so numbers and booleans keep their types in the flow
+func (a *automation360) SendEvent(eventName string, email string, phone string, variables map[string]interface{}) error {
+	return a.SendEventContext(context.Background(), eventName, email, phone, variables)
+}
+
+func (a *automation360) SendEventContext(ctx context.Context, eventName string, email string, phone string, variables map[string]interface{}) error {
+	path := fmt.Sprintf("/events/name/%s", url.PathEscape(eventName))
+	ctx = withRoute(ctx, "/events/name/{name}")
+
+	if email == "" && phone == "" {
+		return ErrNoEventIdentifier
 	}
 
-	body, err := a.Client.makeRequest(path, "POST", variables, true)
+	payload := make(map[string]interface{}, len(variables)+2)
+	for name, value := range variables {
+		payload[name] = value
+	}
+	if email != "" {
+		payload["email"] = email
+	}
+	if phone != "" {
+		payload["phone"] = phone
+	}
+
+	body, err := a.Client.makeJSONRequest(ctx, path, "POST", payload)
 	if err != nil {
 		return err
 	}
 
-	var respData map[string]interface{}
+	return a.Client.checkResult("POST", path, body)
+}
+
+// AutomationStatus is the state of an Automation360 flow
+type AutomationStatus int
+
+const (
+	AutomationStatusStopped AutomationStatus = 0
+	AutomationStatusActive  AutomationStatus = 1
+	AutomationStatusDraft   AutomationStatus = 2
+)
+
+func (s AutomationStatus) String() string {
+	switch s {
+	case AutomationStatusStopped:
+		return "stopped"
+	case AutomationStatusActive:
+		return "active"
+	case AutomationStatusDraft:
+		return "draft"
+	}
+	return fmt.Sprintf("unknown (%d)", int(s))
+}
+
+type Automation struct {
+	ID      int
+	Name    string
+	Status  AutomationStatus
+	Created time.Time
+	Changed time.Time
+}
+
+// AutomationMessageStats is the statistics of a single message (email, SMS, push, ...) of the flow
+type AutomationMessageStats struct {
+	ID        int
+	Type      string
+	Name      string
+	Sent      int
+	Delivered int
+	Opened    int
+	Clicked   int
+	Errors    int
+}
+
+type AutomationDetail struct {
+	Automation
+	TriggerType string
+	Starts      int
+	InQueue     int
+	Completed   int
+	Messages    []AutomationMessageStats
+}
+
+// AutomationStateError is returned when SendPulse rejects starting or stopping a flow, e.g. starting a running one.
+// Message is the explanation given by the server
+type AutomationStateError struct {
+	ID      int
+	Action  string
+	Message string
+	Err     error
+}
+
+func (e *AutomationStateError) Error() string {
+	return fmt.Sprintf("can't %s automation %d: %s", e.Action, e.ID, e.Message)
+}
+
+func (e *AutomationStateError) Unwrap() error {
+	return e.Err
+}
+
+type automationRaw struct {
+	ID      interface{} `json:"id"`
+	Name    string      `json:"name"`
+	Status  interface{} `json:"status"`
+	Created string      `json:"created"`
+	Changed string      `json:"changed"`
+}
+
+func (raw automationRaw) automation() Automation {
+	automation := Automation{
+		ID:     toInt(raw.ID),
+		Name:   raw.Name,
+		Status: AutomationStatus(toInt(raw.Status)),
+	}
+	if created, err := time.Parse(sendDateLayout, raw.Created); err == nil {
+		automation.Created = created
+	}
+	if changed, err := time.Parse(sendDateLayout, raw.Changed); err == nil {
+		automation.Changed = changed
+	}
+	return automation
+}
+
+type automationFlowRaw struct {
+	ID     interface{} `json:"id"`
+	AfType string      `json:"af_type"`
+	Task   struct {
+		Name string `json:"name"`
+	} `json:"task"`
+	Statistics struct {
+		Sent      interface{} `json:"sent"`
+		Delivered interface{} `json:"delivered"`
+		Opened    interface{} `json:"opened"`
+		Clicked   interface{} `json:"clicked"`
+		Errors    interface{} `json:"errors"`
+	} `json:"statistics"`
+}
+
+type automationDetailRaw struct {
+	Autoresponder struct {
+		automationRaw
+		TriggerType string `json:"trigger_type"`
+	} `json:"autoresponder"`
+	Flows    []automationFlowRaw `json:"flows"`
+	Starts   interface{}         `json:"starts"`
+	InQueue  interface{}         `json:"in_queue"`
+	EndCount interface{}         `json:"end_count"`
+}
+
+func (a *automation360) List() ([]Automation, error) {
+	return a.ListContext(context.Background())
+}
+
+func (a *automation360) ListContext(ctx context.Context) ([]Automation, error) {
+	path := "/a360/autoresponders/list"
+
+	body, err := a.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	automations := make([]Automation, 0)
+	if isEmptyCollection(body) {
+		return automations, nil
+	}
+
+	var respData struct {
+		Data []automationRaw `json:"data"`
+	}
 	if err := json.Unmarshal(body, &respData); err != nil {
-		return &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+		return nil, a.Client.invalidResponse("GET", path, body, err.Error())
 	}
 
-	result, resultExists := respData["result"]
-	if !resultExists || !result.(bool) {
-		return &SendpulseError{http.StatusOK, path, string(body), "invalid response"}
+	for _, raw := range respData.Data {
+		automations = append(automations, raw.automation())
+	}
+	return automations, nil
+}
+
+func (a *automation360) Get(automationID int) (*AutomationDetail, error) {
+	return a.GetContext(context.Background(), automationID)
+}
+
+func (a *automation360) GetContext(ctx context.Context, automationID int) (*AutomationDetail, error) {
+	path := fmt.Sprintf("/a360/autoresponders/%d", automationID)
+
+	body, err := a.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
 	}
 
+	var raw automationDetailRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, a.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	detail := AutomationDetail{
+		Automation:  raw.Autoresponder.automation(),
+		TriggerType: raw.Autoresponder.TriggerType,
+		Starts:      toInt(raw.Starts),
+		InQueue:     toInt(raw.InQueue),
+		Completed:   toInt(raw.EndCount),
+		Messages:    make([]AutomationMessageStats, 0, len(raw.Flows)),
+	}
+	for _, flow := range raw.Flows {
+		detail.Messages = append(detail.Messages, AutomationMessageStats{
+			ID:        toInt(flow.ID),
+			Type:      flow.AfType,
+			Name:      flow.Task.Name,
+			Sent:      toInt(flow.Statistics.Sent),
+			Delivered: toInt(flow.Statistics.Delivered),
+			Opened:    toInt(flow.Statistics.Opened),
+			Clicked:   toInt(flow.Statistics.Clicked),
+			Errors:    toInt(flow.Statistics.Errors),
+		})
+	}
+	return &detail, nil
+}
+
+func (a *automation360) Start(automationID int) error {
+	return a.StartContext(context.Background(), automationID)
+}
+
+func (a *automation360) StartContext(ctx context.Context, automationID int) error {
+	return a.changeState(ctx, automationID, "start")
+}
+
+func (a *automation360) Stop(automationID int) error {
+	return a.StopContext(context.Background(), automationID)
+}
+
+func (a *automation360) StopContext(ctx context.Context, automationID int) error {
+	return a.changeState(ctx, automationID, "stop")
+}
+
+// changeState starts or stops the flow turning rejections of the transition into *AutomationStateError
+func (a *automation360) changeState(ctx context.Context, automationID int, action string) error {
+	path := fmt.Sprintf("/a360/autoresponders/%d/%s", automationID, action)
+
+	body, err := a.Client.makeRequest(ctx, path, "POST", nil, true)
+	if err != nil {
+		var spErr *SendpulseError
+		if errors.As(err, &spErr) && spErr.IsValidationError() {
+			return &AutomationStateError{ID: automationID, Action: action, Message: spErr.ErrorDescription, Err: err}
+		}
+		return err
+	}
+
+	if err := a.Client.checkResult("POST", path, body); err != nil {
+		var respData struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(body, &respData) == nil && respData.Message != "" {
+			return &AutomationStateError{ID: automationID, Action: action, Message: respData.Message, Err: err}
+		}
+		return err
+	}
 	return nil
 }
@@ -0,0 +1,45 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestAutomation360_Get_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/a360/autoresponders/101",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"autoresponder": {"id": 101, "name": "Welcome", "status": 1, "trigger_type": "book_subscribe", "created": "2021-01-10 09:00:00"},
+			"flows": [
+				{"id": 5001, "af_type": "email", "task": {"name": "Hello"}, "statistics": {"sent": 120, "delivered": "118", "opened": 64, "clicked": 12, "errors": 2}},
+				{"id": 5002, "af_type": "sms", "task": {"name": "Reminder"}, "statistics": {"sent": "40", "delivered": 40}}
+			],
+			"starts": 150, "in_queue": "12", "end_count": 100
+		}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	detail, err := spClient.Emails.Automation360.Get(101)
+	assert.NoError(t, err)
+	assert.Equal(t, "Welcome", detail.Name)
+	assert.Equal(t, AutomationStatusActive, detail.Status)
+	assert.Equal(t, "book_subscribe", detail.TriggerType)
+	assert.Equal(t, 150, detail.Starts)
+	assert.Equal(t, 12, detail.InQueue)
+	assert.Equal(t, 100, detail.Completed)
+	assert.Equal(t, []AutomationMessageStats{
+		{ID: 5001, Type: "email", Name: "Hello", Sent: 120, Delivered: 118, Opened: 64, Clicked: 12, Errors: 2},
+		{ID: 5002, Type: "sms", Name: "Reminder", Sent: 40, Delivered: 40},
+	}, detail.Messages)
+}
@@ -0,0 +1,69 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAutomation360_List_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/a360/autoresponders/list",
+		httpmock.NewStringResponder(http.StatusOK, `{"data": [
+			{"id": 101, "name": "Welcome", "status": 1, "created": "2021-01-10 09:00:00", "changed": "2021-02-10 09:00:00"},
+			{"id": "102", "name": "Abandoned cart", "status": "0", "created": "2021-03-10 09:00:00", "changed": "2021-03-11 09:00:00"}
+		], "total": 2}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	automations, err := spClient.Emails.Automation360.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []Automation{
+		{
+			ID:      101,
+			Name:    "Welcome",
+			Status:  AutomationStatusActive,
+			Created: time.Date(2021, 1, 10, 9, 0, 0, 0, time.UTC),
+			Changed: time.Date(2021, 2, 10, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      102,
+			Name:    "Abandoned cart",
+			Status:  AutomationStatusStopped,
+			Created: time.Date(2021, 3, 10, 9, 0, 0, 0, time.UTC),
+			Changed: time.Date(2021, 3, 11, 9, 0, 0, 0, time.UTC),
+		},
+	}, automations)
+}
+
+func TestAutomation360_List_Empty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/a360/autoresponders/list",
+		httpmock.NewStringResponder(http.StatusOK, `{"data": [], "total": 0}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	automations, err := spClient.Emails.Automation360.List()
+	assert.NoError(t, err)
+	assert.NotNil(t, automations)
+	assert.Len(t, automations, 0)
+}
@@ -0,0 +1,76 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestAutomation360_SendEvent_Purchase(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/events/name/purchase",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Automation360.SendEvent("purchase", "john@example.com", "", map[string]interface{}{
+		"order_id": 1042,
+		"total":    99.5,
+		"product":  "Headphones",
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"email": "john@example.com", "order_id": 1042, "total": 99.5, "product": "Headphones"}`, requestBody)
+}
+
+func TestAutomation360_SendEvent_PhoneOnly(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/events/name/purchase",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Automation360.SendEvent("purchase", "", "380501234567", nil)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"phone": "380501234567"}`, requestBody)
+}
+
+func TestAutomation360_SendEvent_NoIdentifier(t *testing.T) {
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+
+	err := spClient.Emails.Automation360.SendEvent("purchase", "", "", map[string]interface{}{"total": 1})
+	assert.Equal(t, ErrNoEventIdentifier, err)
+}
@@ -0,0 +1,76 @@
+package sendpulse
+
+import (
+	"errors"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestAutomation360_Start_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/a360/autoresponders/101/start",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	assert.NoError(t, spClient.Emails.Automation360.Start(101))
+}
+
+func TestAutomation360_Start_AlreadyRunning(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/a360/autoresponders/101/start",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"error_code": 400, "message": "Autoresponder is already active"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Automation360.Start(101)
+	var stateErr *AutomationStateError
+	assert.True(t, errors.As(err, &stateErr))
+	assert.Equal(t, "start", stateErr.Action)
+	assert.Equal(t, "Autoresponder is already active", stateErr.Message)
+
+	var spErr *SendpulseError
+	assert.True(t, errors.As(err, &spErr))
+	assert.Equal(t, http.StatusBadRequest, spErr.HttpCode)
+}
+
+func TestAutomation360_Stop_Rejected(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/a360/autoresponders/102/stop",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": false, "message": "Autoresponder is not active"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Automation360.Stop(102)
+	var stateErr *AutomationStateError
+	assert.True(t, errors.As(err, &stateErr))
+	assert.Equal(t, 102, stateErr.ID)
+	assert.Equal(t, "Autoresponder is not active", stateErr.Message)
+}
@@ -151,3 +151,18 @@ func TestAutomation360_StartEvent_BadResult(t *testing.T) {
 	_, isResponseError := err.(*SendpulseError)
 	assert.True(t, isResponseError)
 }
+
+func TestAutomation360_StartEvent_EscapedName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/events/name/order%2Fpaid%20twice",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Automation360.StartEvent("order/paid twice", map[string]interface{}{"email": "john@example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
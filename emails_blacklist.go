@@ -0,0 +1,86 @@
+package sendpulse
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"strings"
+)
+
+type blacklist struct {
+	Client *client
+}
+
+// SendPulse expects the emails list as a base64-encoded string of comma-separated emails
+func encodeEmailsList(emails []string) string {
+	return b64.StdEncoding.EncodeToString([]byte(strings.Join(emails, ",")))
+}
+
+func (b *blacklist) Add(emails []string, comment string) error {
+	return b.AddContext(context.Background(), emails, comment)
+}
+
+func (b *blacklist) AddContext(ctx context.Context, emails []string, comment string) error {
+	path := "/blacklist"
+
+	if len(emails) == 0 {
+		return ErrEmptyEmails
+	}
+
+	data := map[string]interface{}{
+		"emails": encodeEmailsList(emails),
+	}
+	if comment != "" {
+		data["comment"] = comment
+	}
+
+	body, err := b.Client.makeRequest(ctx, path, "POST", data, true)
+	if err != nil {
+		return err
+	}
+
+	return b.Client.checkResult("POST", path, body)
+}
+
+func (b *blacklist) Remove(emails []string) error {
+	return b.RemoveContext(context.Background(), emails)
+}
+
+func (b *blacklist) RemoveContext(ctx context.Context, emails []string) error {
+	path := "/blacklist"
+
+	if len(emails) == 0 {
+		return ErrEmptyEmails
+	}
+
+	data := map[string]interface{}{
+		"emails": encodeEmailsList(emails),
+	}
+
+	body, err := b.Client.makeRequest(ctx, path, "DELETE", data, true)
+	if err != nil {
+		return err
+	}
+
+	return b.Client.checkResult("DELETE", path, body)
+}
+
+func (b *blacklist) List() ([]string, error) {
+	return b.ListContext(context.Background())
+}
+
+func (b *blacklist) ListContext(ctx context.Context) ([]string, error) {
+	path := "/blacklist"
+
+	body, err := b.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var emails []string
+	if err := json.Unmarshal(body, &emails); err != nil {
+		return nil, b.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	return emails, nil
+}
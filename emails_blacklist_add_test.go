@@ -0,0 +1,69 @@
+package sendpulse
+
+import (
+	b64 "encoding/base64"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestBlacklist_Add_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var emails, comment string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/blacklist",
+		func(req *http.Request) (*http.Response, error) {
+			req.ParseForm()
+			emails = req.PostForm.Get("emails")
+			comment = req.PostForm.Get("comment")
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Blacklist.Add([]string{"alice@example.com", "bob@example.com"}, "Asked via support")
+	assert.NoError(t, err)
+	assert.Equal(t, b64.StdEncoding.EncodeToString([]byte("alice@example.com,bob@example.com")), emails)
+	assert.Equal(t, "Asked via support", comment)
+}
+
+func TestBlacklist_Add_Empty(t *testing.T) {
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+
+	assert.Equal(t, ErrEmptyEmails, spClient.Emails.Blacklist.Add([]string{}, ""))
+}
+
+func TestBlacklist_Add_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/blacklist",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"is_error": true, "message": "Invalid emails"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Blacklist.Add([]string{fake.EmailAddress()}, "")
+	spErr, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+	assert.True(t, spErr.IsValidationError())
+}
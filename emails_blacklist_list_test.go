@@ -0,0 +1,50 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestBlacklist_List_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/blacklist",
+		httpmock.NewStringResponder(http.StatusOK, `["alice@example.com", "bob@example.com"]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	emails, err := spClient.Emails.Blacklist.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice@example.com", "bob@example.com"}, emails)
+}
+
+func TestBlacklist_List_BadJson(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/blacklist",
+		httpmock.NewStringResponder(http.StatusOK, `Invalid json`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	emails, err := spClient.Emails.Blacklist.List()
+	assert.Nil(t, emails)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
@@ -0,0 +1,69 @@
+package sendpulse
+
+import (
+	b64 "encoding/base64"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestBlacklist_Remove_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var emails string
+	httpmock.RegisterResponder("DELETE", apiBaseUrl+"/blacklist",
+		func(req *http.Request) (*http.Response, error) {
+			body := make([]byte, req.ContentLength)
+			req.Body.Read(body)
+			values, _ := url.ParseQuery(string(body))
+			emails = values.Get("emails")
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Blacklist.Remove([]string{"alice@example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, b64.StdEncoding.EncodeToString([]byte("alice@example.com")), emails)
+}
+
+func TestBlacklist_Remove_Empty(t *testing.T) {
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+
+	assert.Equal(t, ErrEmptyEmails, spClient.Emails.Blacklist.Remove(nil))
+}
+
+func TestBlacklist_Remove_InvalidResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("DELETE", apiBaseUrl+"/blacklist",
+		httpmock.NewStringResponder(http.StatusOK, `{"foo": true}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Blacklist.Remove([]string{fake.EmailAddress()})
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
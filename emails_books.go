@@ -1,11 +1,13 @@
 package sendpulse
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
-	"strconv"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -41,18 +43,51 @@ type Variable struct {
 	Value interface{}
 }
 
+// Types of address book variables, as in Variable.Type
+const (
+	VariableTypeString = "string"
+	VariableTypeNumber = "number"
+	VariableTypeDate   = "date"
+)
+
 type contactRaw struct {
-	Email         string
-	Status        interface{}
-	StatusExplain string
-	Variables     []Variable
+	Email         string      `json:"email"`
+	Status        interface{} `json:"status"`
+	StatusExplain string      `json:"status_explain"`
+	Variables     []Variable  `json:"variables"`
+}
+
+type emailGlobalInfoRaw struct {
+	contactRaw
+	BookID interface{} `json:"book_id"`
 }
 
+// ErrEmptyBook is returned by CampaignCost when the address book has no recipients to send a campaign to
+var ErrEmptyBook = errors.New("address book has no recipients")
+
+// ErrEmailNotFound is returned when the email isn't added to the address book (or to any of them)
+var ErrEmailNotFound = errors.New("email not found")
+
+// ErrUnsupportedStatus is returned by UpdateEmailsStatus for the statuses the API can't set
+var ErrUnsupportedStatus = errors.New("contact status can't be set through the API")
+
+// ContactStatus is the status of a contact in an address book, as in Contact.Status
+type ContactStatus int
+
+const (
+	ContactStatusNew          ContactStatus = 0
+	ContactStatusActive       ContactStatus = 1
+	ContactStatusUnsubscribed ContactStatus = 2
+)
+
+// ErrVariableNotFound is returned by EmailsByVariable when the address book has no such variable
+var ErrVariableNotFound = errors.New("variable not found in address book")
+
 type Contact struct {
-	Email         string
-	Status        int
-	StatusExplain string
-	Variables     []Variable
+	Email         string     `json:"email"`
+	Status        int        `json:"status"`
+	StatusExplain string     `json:"status_explain"`
+	Variables     []Variable `json:"variables"`
 }
 
 type Email struct {
@@ -68,6 +103,9 @@ type campaignCostRaw struct {
 	AddressesDeltaFromTariff  interface{}
 	MaxEmailsPerTask          interface{}
 	Result                    bool
+	// The API names these two in snake case unlike the rest
+	SentEmailsQtySnake    interface{} `json:"sent_emails_qty"`
+	MaxEmailsPerTaskSnake interface{} `json:"max_emails_per_task"`
 }
 
 type CampaignCost struct {
@@ -86,62 +124,78 @@ type Task struct {
 	Status int    `json:"task_status"`
 }
 
+// Segment is a segment of an address book saved in SendPulse
+type Segment struct {
+	ID     int
+	Name   string
+	BookID int
+}
+
+type segmentRaw struct {
+	ID     interface{} `json:"id"`
+	Name   string      `json:"name"`
+	BookID interface{} `json:"book_id"`
+}
+
 func (b *books) Create(addressBookName string) (*int, error) {
+	return b.CreateContext(context.Background(), addressBookName)
+}
+
+func (b *books) CreateContext(ctx context.Context, addressBookName string) (*int, error) {
 	path := "/addressbooks"
 
 	data := map[string]interface{}{
 		"bookName": addressBookName,
 	}
-	body, err := b.Client.makeRequest(fmt.Sprintf(path), "POST", data, true)
+	body, err := b.Client.makeRequest(ctx, path, "POST", data, true)
 	if err != nil {
 		return nil, err
 	}
 
-	var respData map[string]int
+	var respData map[string]interface{}
 	if err := json.Unmarshal(body, &respData); err != nil {
-		return nil, &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+		return nil, b.Client.invalidResponse("POST", path, body, err.Error())
 	}
 
-	createdBookId, idExists := respData["id"]
-	if !idExists {
-		return nil, &SendpulseError{http.StatusOK, path, string(body), "'id' not found in response"}
+	createdBookId := toInt(respData["id"])
+	if createdBookId == 0 {
+		return nil, b.Client.invalidResponse("POST", path, body, "'id' not found in response")
 	}
+	b.Client.booksTotal.reset()
 
-	return &createdBookId, err
+	return &createdBookId, nil
 }
 
 func (b *books) Update(addressBookId int, name string) error {
+	return b.UpdateContext(context.Background(), addressBookId, name)
+}
+
+func (b *books) UpdateContext(ctx context.Context, addressBookId int, name string) error {
 	path := fmt.Sprintf("/addressbooks/%d", addressBookId)
 
 	data := map[string]interface{}{
 		"name": name,
 	}
 
-	body, err := b.Client.makeRequest(fmt.Sprintf(path), "PUT", data, true)
+	body, err := b.Client.makeRequest(ctx, path, "PUT", data, true)
 	if err != nil {
 		return err
 	}
 
-	var respData map[string]interface{}
-	if err := json.Unmarshal(body, &respData); err != nil {
-		return &SendpulseError{http.StatusOK, path, string(body), err.Error()}
-	}
-
-	result, resultExists := respData["result"]
-	if !resultExists || !result.(bool) {
-		return &SendpulseError{http.StatusOK, path, string(body), "invalid response"}
-	}
-
-	return nil
+	return b.Client.checkResult("PUT", path, body)
 }
 
 func (b *books) List(limit int, offset int) ([]Book, error) {
+	return b.ListContext(context.Background(), limit, offset)
+}
+
+func (b *books) ListContext(ctx context.Context, limit int, offset int) ([]Book, error) {
 	path := "/addressbooks"
 	data := map[string]interface{}{
 		"limit":  fmt.Sprint(limit),
 		"offset": fmt.Sprint(offset),
 	}
-	body, err := b.Client.makeRequest(path, "GET", data, true)
+	body, err := b.Client.makeRequest(ctx, path, "GET", data, true)
 
 	if err != nil {
 		return nil, err
@@ -149,16 +203,16 @@ func (b *books) List(limit int, offset int) ([]Book, error) {
 
 	var respData []bookRaw
 	if err := json.Unmarshal(body, &respData); err != nil {
-		return nil, &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+		return nil, b.Client.invalidResponse("GET", path, body, err.Error())
 	}
 
 	var books []Book
 	for _, raw := range respData {
-		id, _ := strconv.Atoi(fmt.Sprint(raw.ID))
-		allEmailQty, _ := strconv.Atoi(fmt.Sprint(raw.AllEmailQty))
-		activeEmailQty, _ := strconv.Atoi(fmt.Sprint(raw.ActiveEmailQty))
-		inactiveEmailQty, _ := strconv.Atoi(fmt.Sprint(raw.InactiveEmailQty))
-		status, _ := strconv.Atoi(fmt.Sprint(raw.Status))
+		id := toInt(raw.ID)
+		allEmailQty := toInt(raw.AllEmailQty)
+		activeEmailQty := toInt(raw.ActiveEmailQty)
+		inactiveEmailQty := toInt(raw.InactiveEmailQty)
+		status := toInt(raw.Status)
 		books = append(books, Book{
 			ID:               id,
 			Name:             raw.Name,
@@ -174,9 +228,54 @@ func (b *books) List(limit int, offset int) ([]Book, error) {
 	return books, nil
 }
 
+// findBooksBatchSize is the number of address books requested per call by Find
+const findBooksBatchSize = 100
+
+// Find returns the address books whose names contain nameContains, case-insensitively.
+// The API can't filter books by name, so Find pages through all of them and filters on the client side:
+// offset and limit apply to the matching books, a zero limit returns all matches
+func (b *books) Find(nameContains string, limit int, offset int) ([]Book, error) {
+	return b.FindContext(context.Background(), nameContains, limit, offset)
+}
+
+func (b *books) FindContext(ctx context.Context, nameContains string, limit int, offset int) ([]Book, error) {
+	needle := strings.ToLower(nameContains)
+
+	found := make([]Book, 0)
+	skipped := 0
+	for listOffset := 0; ; listOffset += findBooksBatchSize {
+		books, err := b.ListContext(ctx, findBooksBatchSize, listOffset)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, book := range books {
+			if !strings.Contains(strings.ToLower(book.Name), needle) {
+				continue
+			}
+			if skipped < offset {
+				skipped++
+				continue
+			}
+			found = append(found, book)
+			if limit > 0 && len(found) == limit {
+				return found, nil
+			}
+		}
+
+		if len(books) < findBooksBatchSize {
+			return found, nil
+		}
+	}
+}
+
 func (b *books) Get(addressBookId int) (*Book, error) {
+	return b.GetContext(context.Background(), addressBookId)
+}
+
+func (b *books) GetContext(ctx context.Context, addressBookId int) (*Book, error) {
 	path := fmt.Sprintf("/addressbooks/%d", addressBookId)
-	body, err := b.Client.makeRequest(path, "GET", nil, true)
+	body, err := b.Client.makeRequest(ctx, path, "GET", nil, true)
 
 	if err != nil {
 		return nil, err
@@ -184,14 +283,18 @@ func (b *books) Get(addressBookId int) (*Book, error) {
 
 	var respData []bookRaw
 	if err := json.Unmarshal(body, &respData); err != nil {
-		return nil, &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+		return nil, b.Client.invalidResponse("GET", path, body, err.Error())
 	}
 
-	id, _ := strconv.Atoi(fmt.Sprint(respData[0].ID))
-	allEmailQty, _ := strconv.Atoi(fmt.Sprint(respData[0].AllEmailQty))
-	activeEmailQty, _ := strconv.Atoi(fmt.Sprint(respData[0].ActiveEmailQty))
-	inactiveEmailQty, _ := strconv.Atoi(fmt.Sprint(respData[0].InactiveEmailQty))
-	status, _ := strconv.Atoi(fmt.Sprint(respData[0].Status))
+	if len(respData) == 0 {
+		return nil, b.Client.invalidResponse("GET", path, body, "address book not found")
+	}
+
+	id := toInt(respData[0].ID)
+	allEmailQty := toInt(respData[0].AllEmailQty)
+	activeEmailQty := toInt(respData[0].ActiveEmailQty)
+	inactiveEmailQty := toInt(respData[0].InactiveEmailQty)
+	status := toInt(respData[0].Status)
 	book := Book{
 		ID:               id,
 		Name:             respData[0].Name,
@@ -206,30 +309,44 @@ func (b *books) Get(addressBookId int) (*Book, error) {
 	return &book, err
 }
 
+// Variables returns the variables defined in the address book with their types. Value isn't set.
+// A book without custom variables gives an empty slice
 func (b *books) Variables(addressBookId int) ([]Variable, error) {
+	return b.VariablesContext(context.Background(), addressBookId)
+}
+
+func (b *books) VariablesContext(ctx context.Context, addressBookId int) ([]Variable, error) {
 	path := fmt.Sprintf("/addressbooks/%d/variables", addressBookId)
-	body, err := b.Client.makeRequest(path, "GET", nil, true)
+	body, err := b.Client.makeRequest(ctx, path, "GET", nil, true)
 
 	if err != nil {
 		return nil, err
 	}
 
-	var variables []Variable
+	variables := make([]Variable, 0)
+	if isEmptyCollection(body) {
+		return variables, nil
+	}
+
 	if err := json.Unmarshal(body, &variables); err != nil {
-		return nil, &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+		return nil, b.Client.invalidResponse("GET", path, body, err.Error())
 	}
 
-	return variables, err
+	return variables, nil
 }
 
 func (b *books) Emails(addressBookId int, limit int, offset int) ([]Contact, error) {
+	return b.EmailsContext(context.Background(), addressBookId, limit, offset)
+}
+
+func (b *books) EmailsContext(ctx context.Context, addressBookId int, limit int, offset int) ([]Contact, error) {
 	path := fmt.Sprintf("/addressbooks/%d/emails", addressBookId)
 
 	data := map[string]interface{}{
 		"limit":  fmt.Sprint(limit),
 		"offset": fmt.Sprint(offset),
 	}
-	body, err := b.Client.makeRequest(path, "GET", data, true)
+	body, err := b.Client.makeRequest(ctx, path, "GET", data, true)
 
 	if err != nil {
 		return nil, err
@@ -237,12 +354,12 @@ func (b *books) Emails(addressBookId int, limit int, offset int) ([]Contact, err
 
 	var contactsRaw []contactRaw
 	if err := json.Unmarshal(body, &contactsRaw); err != nil {
-		return nil, &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+		return nil, b.Client.invalidResponse("GET", path, body, err.Error())
 	}
 
 	var contacts []Contact
 	for _, raw := range contactsRaw {
-		status, _ := strconv.Atoi(fmt.Sprint(raw.Status))
+		status := toInt(raw.Status)
 		contacts = append(contacts, Contact{
 			Email:         raw.Email,
 			Status:        status,
@@ -253,25 +370,180 @@ func (b *books) Emails(addressBookId int, limit int, offset int) ([]Contact, err
 	return contacts, err
 }
 
+// EmailsByVariable returns the contacts of the address book whose variable has the given value.
+// Strings and numbers are sent as is and time.Time as a date (YYYY-MM-DD)
+func (b *books) EmailsByVariable(addressBookId int, variableName string, value interface{}) ([]Contact, error) {
+	return b.EmailsByVariableContext(context.Background(), addressBookId, variableName, value)
+}
+
+func (b *books) EmailsByVariableContext(ctx context.Context, addressBookId int, variableName string, value interface{}) ([]Contact, error) {
+	path := fmt.Sprintf("/addressbooks/%d/variables/%s/%s", addressBookId, url.PathEscape(variableName), url.PathEscape(variableValue(value)))
+	ctx = withRoute(ctx, "/addressbooks/{id}/variables/{name}/{value}")
+
+	body, err := b.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		if spErr, ok := err.(*SendpulseError); ok && spErr.HttpCode == http.StatusNotFound {
+			return nil, ErrVariableNotFound
+		}
+		return nil, err
+	}
+
+	contacts := make([]Contact, 0)
+	if isEmptyCollection(body) {
+		return contacts, nil
+	}
+
+	var contactsRaw []contactRaw
+	if err := json.Unmarshal(body, &contactsRaw); err != nil {
+		return nil, b.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range contactsRaw {
+		contacts = append(contacts, Contact{
+			Email:         raw.Email,
+			Status:        toInt(raw.Status),
+			StatusExplain: raw.StatusExplain,
+			Variables:     raw.Variables,
+		})
+	}
+	return contacts, nil
+}
+
+// variableValue formats a variable value the way SendPulse stores it
+func variableValue(value interface{}) string {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Format("2006-01-02")
+	}
+	return toString(value)
+}
+
+func (b *books) EmailInfo(addressBookId int, email string) (*Contact, error) {
+	return b.EmailInfoContext(context.Background(), addressBookId, email)
+}
+
+func (b *books) EmailInfoContext(ctx context.Context, addressBookId int, email string) (*Contact, error) {
+	path := fmt.Sprintf("/addressbooks/%d/emails/%s", addressBookId, url.PathEscape(email))
+
+	body, err := b.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		if spErr, ok := err.(*SendpulseError); ok && spErr.HttpCode == http.StatusNotFound {
+			return nil, ErrEmailNotFound
+		}
+		return nil, err
+	}
+
+	var raw contactRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, b.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	if raw.Email == "" {
+		return nil, ErrEmailNotFound
+	}
+
+	status := toInt(raw.Status)
+	contact := Contact{
+		Email:         raw.Email,
+		Status:        status,
+		StatusExplain: raw.StatusExplain,
+		Variables:     raw.Variables,
+	}
+	return &contact, nil
+}
+
+// EmailGlobalInfo returns the email info in every address book it's added to, by address book id
+func (b *books) EmailGlobalInfo(email string) (map[int]Contact, error) {
+	return b.EmailGlobalInfoContext(context.Background(), email)
+}
+
+func (b *books) EmailGlobalInfoContext(ctx context.Context, email string) (map[int]Contact, error) {
+	path := fmt.Sprintf("/emails/%s", url.PathEscape(email))
+
+	body, err := b.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		if spErr, ok := err.(*SendpulseError); ok && spErr.HttpCode == http.StatusNotFound {
+			return nil, ErrEmailNotFound
+		}
+		return nil, err
+	}
+
+	var respData []emailGlobalInfoRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, b.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	if len(respData) == 0 {
+		return nil, ErrEmailNotFound
+	}
+
+	contacts := make(map[int]Contact)
+	for _, raw := range respData {
+		bookID := toInt(raw.BookID)
+		status := toInt(raw.Status)
+		contacts[bookID] = Contact{
+			Email:         raw.Email,
+			Status:        status,
+			StatusExplain: raw.StatusExplain,
+			Variables:     raw.Variables,
+		}
+	}
+	return contacts, nil
+}
+
+// IterateEmails calls fn for every contact of the address book requesting them by batchSize per call.
+// It stops when a page is shorter than batchSize or fn returns an error
+func (b *books) IterateEmails(addressBookId int, batchSize int, fn func(Contact) error) error {
+	return b.IterateEmailsContext(context.Background(), addressBookId, batchSize, fn)
+}
+
+func (b *books) IterateEmailsContext(ctx context.Context, addressBookId int, batchSize int, fn func(Contact) error) error {
+	if batchSize <= 0 {
+		return errors.New("batch size must be positive")
+	}
+
+	for offset := 0; ; offset += batchSize {
+		contacts, err := b.EmailsContext(ctx, addressBookId, batchSize, offset)
+		if err != nil {
+			return err
+		}
+
+		for _, contact := range contacts {
+			if err := fn(contact); err != nil {
+				return err
+			}
+		}
+
+		if len(contacts) < batchSize {
+			return nil
+		}
+	}
+}
+
 func (b *books) EmailsTotal(addressBookId int) (int, error) {
+	return b.EmailsTotalContext(context.Background(), addressBookId)
+}
+
+func (b *books) EmailsTotalContext(ctx context.Context, addressBookId int) (int, error) {
 	path := fmt.Sprintf("/addressbooks/%d/emails/total", addressBookId)
 
-	body, err := b.Client.makeRequest(fmt.Sprintf(path), "GET", nil, true)
+	body, err := b.Client.makeRequest(ctx, path, "GET", nil, true)
 	if err != nil {
 		return 0, err
 	}
 
-	var respData map[string]interface{}
+	var respData struct {
+		Total *json.Number `json:"total"`
+	}
 	if err := json.Unmarshal(body, &respData); err != nil {
-		return 0, &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+		return 0, b.Client.invalidResponse("GET", path, body, err.Error())
 	}
 
-	total, totalExists := respData["total"]
-	if !totalExists {
-		return 0, &SendpulseError{http.StatusOK, path, string(body), "'total' not found in response"}
+	if respData.Total == nil {
+		return 0, b.Client.invalidResponse("GET", path, body, "'total' not found in response")
 	}
 
-	return int(total.(float64)), nil
+	return toInt(*respData.Total), nil
 }
 
 /**
@@ -283,16 +555,15 @@ Known limitations:
 -- Sendpulse don't remove previous user variables if user already added to address book before
 */
 func (b *books) AddEmails(addressBookId int, notifications []Email, additionalParams map[string]string, senderEmail string) error {
-	path := fmt.Sprintf("/addressbooks/%d/emails", addressBookId)
-
-	encoded, err := json.Marshal(notifications)
+	return b.AddEmailsContext(context.Background(), addressBookId, notifications, additionalParams, senderEmail)
+}
 
-	if err != nil {
-		return errors.New("could not to encode emails list")
-	}
+func (b *books) AddEmailsContext(ctx context.Context, addressBookId int, notifications []Email, additionalParams map[string]string, senderEmail string) error {
+	path := fmt.Sprintf("/addressbooks/%d/emails", addressBookId)
 
+	// Sent as JSON to keep variable types: numbers and dates aren't turned into strings
 	data := map[string]interface{}{
-		"emails": string(encoded),
+		"emails": notifications,
 	}
 
 	if senderEmail != "" { // double-opt-in method
@@ -306,114 +577,186 @@ func (b *books) AddEmails(addressBookId int, notifications []Email, additionalPa
 		}
 	}
 
-	body, err := b.Client.makeRequest(path, "POST", data, true)
+	body, err := b.Client.makeJSONRequest(ctx, path, "POST", data)
 
 	if err != nil {
 		return err
 	}
 
-	var respData map[string]interface{}
-	if err := json.Unmarshal(body, &respData); err != nil {
-		return &SendpulseError{http.StatusOK, path, string(body), err.Error()}
-	}
-	result, resultExists := respData["result"]
-	if !resultExists || !result.(bool) {
-		return &SendpulseError{http.StatusOK, path, string(body), "invalid response"}
-	}
-	return nil
+	return b.Client.checkResult("POST", path, body)
 }
 
 func (b *books) DeleteEmails(addressBookId int, emailsList []string) error {
+	return b.DeleteEmailsContext(context.Background(), addressBookId, emailsList)
+}
+
+func (b *books) DeleteEmailsContext(ctx context.Context, addressBookId int, emailsList []string) error {
 	path := fmt.Sprintf("/addressbooks/%d/emails", addressBookId)
 
-	encoded, err := json.Marshal(emailsList)
+	data := map[string]interface{}{
+		"emails": emailsList,
+	}
+	body, err := b.Client.makeJSONRequest(ctx, path, "DELETE", data)
 	if err != nil {
-		return errors.New("could not to encode emails list")
+		return err
+	}
+
+	return b.Client.checkResult("DELETE", path, body)
+}
+
+func (b *books) UnsubscribeEmails(addressBookId int, emailsList []string) error {
+	return b.UnsubscribeEmailsContext(context.Background(), addressBookId, emailsList)
+}
+
+func (b *books) UnsubscribeEmailsContext(ctx context.Context, addressBookId int, emailsList []string) error {
+	path := fmt.Sprintf("/addressbooks/%d/emails/unsubscribe", addressBookId)
+
+	if len(emailsList) == 0 {
+		return ErrEmptyEmails
 	}
 
 	data := map[string]interface{}{
-		"emails": string(encoded),
+		"emails": emailsList,
 	}
-	body, err := b.Client.makeRequest(path, "DELETE", data, true)
+	body, err := b.Client.makeJSONRequest(ctx, path, "POST", data)
 	if err != nil {
 		return err
 	}
 
-	var respData map[string]interface{}
-	if err := json.Unmarshal(body, &respData); err != nil {
-		return &SendpulseError{http.StatusOK, path, string(body), err.Error()}
-	}
-	result, resultExists := respData["result"]
-	if !resultExists || !result.(bool) {
-		return &SendpulseError{http.StatusOK, path, string(body), "invalid response"}
+	return b.Client.checkResult("POST", path, body)
+}
+
+// UpdateEmailsStatus sets the status of the contacts of the address book.
+// The API only allows unsubscribing, so any other status gives ErrUnsupportedStatus
+func (b *books) UpdateEmailsStatus(addressBookId int, emailsList []string, status ContactStatus) error {
+	return b.UpdateEmailsStatusContext(context.Background(), addressBookId, emailsList, status)
+}
+
+func (b *books) UpdateEmailsStatusContext(ctx context.Context, addressBookId int, emailsList []string, status ContactStatus) error {
+	if status != ContactStatusUnsubscribed {
+		return ErrUnsupportedStatus
 	}
-	return nil
+	return b.UnsubscribeEmailsContext(ctx, addressBookId, emailsList)
 }
 
 func (b *books) Delete(addressBookId int) error {
+	return b.DeleteContext(context.Background(), addressBookId)
+}
+
+func (b *books) DeleteContext(ctx context.Context, addressBookId int) error {
 	path := fmt.Sprintf("/addressbooks/%d", addressBookId)
-	body, err := b.Client.makeRequest(path, "DELETE", nil, true)
+	body, err := b.Client.makeRequest(ctx, path, "DELETE", nil, true)
 	if err != nil {
 		return err
 	}
-	var respData map[string]interface{}
-	if err := json.Unmarshal(body, &respData); err != nil {
-		return &SendpulseError{http.StatusOK, path, string(body), err.Error()}
-	}
-	result, resultExists := respData["result"]
-	if !resultExists || !result.(bool) {
-		return &SendpulseError{http.StatusOK, path, string(body), "invalid response"}
-	}
-	return nil
+	b.Client.booksTotal.reset()
+	return b.Client.checkResult("DELETE", path, body)
 }
 
 func (b *books) CampaignCost(addressBookId int) (*CampaignCost, error) {
+	return b.CampaignCostContext(context.Background(), addressBookId)
+}
+
+func (b *books) CampaignCostContext(ctx context.Context, addressBookId int) (*CampaignCost, error) {
 	path := fmt.Sprintf("/addressbooks/%d/cost", addressBookId)
 
-	body, err := b.Client.makeRequest(fmt.Sprintf(path), "GET", nil, true)
+	body, err := b.Client.makeRequest(ctx, path, "GET", nil, true)
 	if err != nil {
 		return nil, err
 	}
 
 	var respData campaignCostRaw
 	if err := json.Unmarshal(body, &respData); err != nil {
-		return nil, &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+		return nil, b.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	sentEmailsQty := respData.SentEmailsQty
+	if respData.SentEmailsQtySnake != nil {
+		sentEmailsQty = respData.SentEmailsQtySnake
+	}
+	if sentEmailsQty == nil {
+		return nil, b.Client.invalidResponse("GET", path, body, "'sent_emails_qty' not found in response")
+	}
+	maxEmailsPerTask := respData.MaxEmailsPerTask
+	if respData.MaxEmailsPerTaskSnake != nil {
+		maxEmailsPerTask = respData.MaxEmailsPerTaskSnake
 	}
 
-	sentEmailsQty, _ := strconv.Atoi(fmt.Sprint(respData.SentEmailsQty))
-	overdraftAllEmailsPrice, _ := strconv.Atoi(fmt.Sprint(respData.OverdraftAllEmailsPrice))
-	addressesDeltaFromBalance, _ := strconv.Atoi(fmt.Sprint(respData.AddressesDeltaFromBalance))
-	addressesDeltaFromTariff, _ := strconv.Atoi(fmt.Sprint(respData.AddressesDeltaFromTariff))
-	maxEmailsPerTask, _ := strconv.Atoi(fmt.Sprint(respData.MaxEmailsPerTask))
 	cost := CampaignCost{
 		Cur:                       respData.Cur,
-		SentEmailsQty:             sentEmailsQty,
-		OverdraftAllEmailsPrice:   overdraftAllEmailsPrice,
-		AddressesDeltaFromBalance: addressesDeltaFromBalance,
-		AddressesDeltaFromTariff:  addressesDeltaFromTariff,
-		MaxEmailsPerTask:          maxEmailsPerTask,
-		Result:                    false,
+		SentEmailsQty:             toInt(sentEmailsQty),
+		OverdraftAllEmailsPrice:   toInt(respData.OverdraftAllEmailsPrice),
+		AddressesDeltaFromBalance: toInt(respData.AddressesDeltaFromBalance),
+		AddressesDeltaFromTariff:  toInt(respData.AddressesDeltaFromTariff),
+		MaxEmailsPerTask:          toInt(maxEmailsPerTask),
+		Result:                    respData.Result,
+	}
+	if cost.SentEmailsQty == 0 {
+		return nil, ErrEmptyBook
 	}
 
-	return &cost, err
+	return &cost, nil
 }
 
+// Campaigns returns the campaigns sent to the address book, so its history can be read without listing every campaign
+// of the account. A book never used in a campaign gives an empty slice
 func (b *books) Campaigns(bookID int, limit int, offset int) ([]Task, error) {
+	return b.CampaignsContext(context.Background(), bookID, limit, offset)
+}
+
+func (b *books) CampaignsContext(ctx context.Context, bookID int, limit int, offset int) ([]Task, error) {
 	path := fmt.Sprintf("/addressbooks/%d/campaigns", bookID)
 	data := map[string]interface{}{
 		"limit":  fmt.Sprint(limit),
 		"offset": fmt.Sprint(offset),
 	}
 
-	body, err := b.Client.makeRequest(path, "GET", data, true)
+	body, err := b.Client.makeRequest(ctx, path, "GET", data, true)
 	if err != nil {
 		return nil, err
 	}
 
-	var tasks []Task
+	tasks := make([]Task, 0)
+	if isEmptyCollection(body) {
+		return tasks, nil
+	}
+
 	if err := json.Unmarshal(body, &tasks); err != nil {
-		return nil, &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+		return nil, b.Client.invalidResponse("GET", path, body, err.Error())
 	}
 
 	return tasks, nil
 }
+
+// Segments returns the segments of the address book. Campaigns are sent to one with CreateCampaignData.SegmentID
+func (b *books) Segments(bookID int) ([]Segment, error) {
+	return b.SegmentsContext(context.Background(), bookID)
+}
+
+func (b *books) SegmentsContext(ctx context.Context, bookID int) ([]Segment, error) {
+	path := fmt.Sprintf("/addressbooks/%d/segments", bookID)
+
+	body, err := b.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]Segment, 0)
+	if isEmptyCollection(body) {
+		return segments, nil
+	}
+
+	var respData []segmentRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, b.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range respData {
+		segment := Segment{ID: toInt(raw.ID), Name: raw.Name, BookID: toInt(raw.BookID)}
+		if segment.BookID == 0 {
+			segment.BookID = bookID
+		}
+		segments = append(segments, segment)
+	}
+	return segments, nil
+}
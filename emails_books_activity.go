@@ -0,0 +1,97 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// EngagementLevel is a bucket of contacts by how recently they opened or clicked a campaign
+type EngagementLevel string
+
+const (
+	// EngagementActive contacts opened or clicked in the last 30 days
+	EngagementActive EngagementLevel = "active"
+	// EngagementLapsed contacts opened or clicked in the last 180 days, but not in the last 30
+	EngagementLapsed EngagementLevel = "lapsed"
+	// EngagementInactive contacts haven't opened or clicked for more than 180 days
+	EngagementInactive EngagementLevel = "inactive"
+	// EngagementNever contacts have no recorded opens or clicks
+	EngagementNever EngagementLevel = "never"
+)
+
+const (
+	engagementActivePeriod = 30 * 24 * time.Hour
+	engagementLapsedPeriod = 180 * 24 * time.Hour
+)
+
+// EmailActivity is the last recorded activity of a contact of an address book.
+// The dates are zero when there was no such activity
+type EmailActivity struct {
+	Email        string
+	LastOpen     time.Time
+	LastClick    time.Time
+	LastActivity time.Time
+	Engagement   EngagementLevel
+}
+
+type emailActivityRaw struct {
+	Email     string `json:"email"`
+	LastOpen  string `json:"last_open"`
+	LastClick string `json:"last_click"`
+}
+
+// EmailActivity returns the dates of the last open and click of the contact and its engagement level
+func (b *books) EmailActivity(addressBookId int, email string) (*EmailActivity, error) {
+	return b.EmailActivityContext(context.Background(), addressBookId, email)
+}
+
+func (b *books) EmailActivityContext(ctx context.Context, addressBookId int, email string) (*EmailActivity, error) {
+	path := fmt.Sprintf("/addressbooks/%d/emails/%s", addressBookId, url.PathEscape(email))
+
+	body, err := b.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		if spErr, ok := err.(*SendpulseError); ok && spErr.HttpCode == http.StatusNotFound {
+			return nil, ErrEmailNotFound
+		}
+		return nil, err
+	}
+
+	var raw emailActivityRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, b.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	if raw.Email == "" {
+		return nil, ErrEmailNotFound
+	}
+
+	activity := EmailActivity{Email: raw.Email}
+	if lastOpen, err := time.Parse(sendDateLayout, raw.LastOpen); err == nil {
+		activity.LastOpen = lastOpen
+	}
+	if lastClick, err := time.Parse(sendDateLayout, raw.LastClick); err == nil {
+		activity.LastClick = lastClick
+	}
+	activity.LastActivity = activity.LastOpen
+	if activity.LastClick.After(activity.LastActivity) {
+		activity.LastActivity = activity.LastClick
+	}
+	activity.Engagement = engagementLevel(activity.LastActivity, time.Now())
+	return &activity, nil
+}
+
+func engagementLevel(lastActivity time.Time, now time.Time) EngagementLevel {
+	switch {
+	case lastActivity.IsZero():
+		return EngagementNever
+	case now.Sub(lastActivity) <= engagementActivePeriod:
+		return EngagementActive
+	case now.Sub(lastActivity) <= engagementLapsedPeriod:
+		return EngagementLapsed
+	}
+	return EngagementInactive
+}
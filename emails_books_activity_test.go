@@ -0,0 +1,60 @@
+package sendpulse
+
+import (
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBooks_EmailActivity(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	lastOpen := time.Now().UTC().Add(-72 * time.Hour).Truncate(time.Second)
+	lastClick := lastOpen.Add(5 * time.Minute)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/1/emails/john+promo@example.com", apiBaseUrl),
+		httpmock.NewStringResponder(http.StatusOK, fmt.Sprintf(`{"email": "john+promo@example.com", "status": 1, "last_open": "%s", "last_click": "%s"}`,
+			lastOpen.Format(sendDateLayout), lastClick.Format(sendDateLayout))))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	activity, err := spClient.Emails.Books.EmailActivity(1, "john+promo@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, &EmailActivity{
+		Email:        "john+promo@example.com",
+		LastOpen:     lastOpen,
+		LastClick:    lastClick,
+		LastActivity: lastClick,
+		Engagement:   EngagementActive,
+	}, activity)
+}
+
+func TestBooks_EmailActivity_NeverEngaged(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/1/emails/john+promo@example.com", apiBaseUrl),
+		httpmock.NewStringResponder(http.StatusOK, `{"email": "john+promo@example.com", "status": 1, "last_open": "", "last_click": null}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	activity, err := spClient.Emails.Books.EmailActivity(1, "john+promo@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, &EmailActivity{Email: "john+promo@example.com", Engagement: EngagementNever}, activity)
+	assert.True(t, activity.LastOpen.IsZero())
+}
+
+func TestEngagementLevel(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, EngagementNever, engagementLevel(time.Time{}, now))
+	assert.Equal(t, EngagementActive, engagementLevel(now.AddDate(0, 0, -30), now))
+	assert.Equal(t, EngagementLapsed, engagementLevel(now.AddDate(0, 0, -31), now))
+	assert.Equal(t, EngagementInactive, engagementLevel(now.AddDate(0, 0, -181), now))
+}
@@ -5,8 +5,10 @@ import (
 	"github.com/icrowley/fake"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestBooks_AddEmails_BadJson(t *testing.T) {
@@ -176,3 +178,60 @@ func TestBooks_AddEmails_Error(t *testing.T) {
 	_, isResponseError := err.(*SendpulseError)
 	assert.True(t, isResponseError)
 }
+
+func TestBooks_AddEmails_RequestBody(t *testing.T) {
+	apiUid := fake.CharactersN(50)
+	apiSecret := fake.CharactersN(50)
+
+	emails := []Email{
+		{
+			Email: "alice@example.com",
+			Variables: map[string]interface{}{
+				"name":     "Alice",
+				"age":      30,
+				"birthday": time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			Email: "bob@example.com",
+			Variables: map[string]interface{}{
+				"name":    "Bob",
+				"balance": 10.5,
+			},
+		},
+	}
+
+	addressBookId := 1
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var contentType string
+	var requestBody string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/addressbooks/%d/emails", apiBaseUrl, addressBookId),
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			contentType = req.Header.Get("Content-Type")
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  apiUid,
+		Secret:  apiSecret,
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Books.AddEmails(addressBookId, emails, nil, "sender@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "application/json", contentType)
+	assert.JSONEq(t, `{
+		"emails": [
+			{"email": "alice@example.com", "variables": {"name": "Alice", "age": 30, "birthday": "1990-05-17T00:00:00Z"}},
+			{"email": "bob@example.com", "variables": {"name": "Bob", "balance": 10.5}}
+		],
+		"confirmation": "force",
+		"sender_email": "sender@example.com"
+	}`, requestBody)
+}
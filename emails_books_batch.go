@@ -0,0 +1,89 @@
+package sendpulse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// FailedBatch is a batch of AddEmailsBatched that wasn't added. Index is its 0-based position in the input
+type FailedBatch struct {
+	Index  int
+	Emails []Email
+	Err    error
+}
+
+// BatchError is returned by AddEmailsBatched when some batches failed. Only Failed need to be retried
+type BatchError struct {
+	Failed    []FailedBatch
+	Succeeded []int
+}
+
+func (e *BatchError) Error() string {
+	messages := make([]string, 0, len(e.Failed))
+	for _, batch := range e.Failed {
+		messages = append(messages, fmt.Sprintf("batch %d: %s", batch.Index, batch.Err.Error()))
+	}
+	return fmt.Sprintf("%d of %d batches failed: %s", len(e.Failed), len(e.Failed)+len(e.Succeeded), strings.Join(messages, "; "))
+}
+
+// AddEmailsBatched adds the contacts to the address book by batchSize per request, sending up to concurrency
+// requests at once. Config.RateLimiter applies to every request, so it bounds the rate whatever the concurrency is.
+// If some batches fail the others are still sent and *BatchError tells which ones to retry
+func (b *books) AddEmailsBatched(addressBookId int, emails []Email, batchSize int, concurrency int) error {
+	return b.AddEmailsBatchedContext(context.Background(), addressBookId, emails, batchSize, concurrency)
+}
+
+func (b *books) AddEmailsBatchedContext(ctx context.Context, addressBookId int, emails []Email, batchSize int, concurrency int) error {
+	if len(emails) == 0 {
+		return ErrEmptyEmails
+	}
+	if batchSize <= 0 {
+		return errors.New("batch size must be positive")
+	}
+	if concurrency <= 0 {
+		return errors.New("concurrency must be positive")
+	}
+
+	batches := make([][]Email, 0, (len(emails)+batchSize-1)/batchSize)
+	for start := 0; start < len(emails); start += batchSize {
+		end := start + batchSize
+		if end > len(emails) {
+			end = len(emails)
+		}
+		batches = append(batches, emails[start:end])
+	}
+
+	results := make([]error, len(batches))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency && i < len(batches); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				results[index] = b.AddEmailsContext(ctx, addressBookId, batches[index], nil, "")
+			}
+		}()
+	}
+	for index := range batches {
+		jobs <- index
+	}
+	close(jobs)
+	wg.Wait()
+
+	batchErr := &BatchError{}
+	for index, err := range results {
+		if err != nil {
+			batchErr.Failed = append(batchErr.Failed, FailedBatch{Index: index, Emails: batches[index], Err: err})
+		} else {
+			batchErr.Succeeded = append(batchErr.Succeeded, index)
+		}
+	}
+	if len(batchErr.Failed) != 0 {
+		return batchErr
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package sendpulse
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func batchEmails(n int) []Email {
+	emails := make([]Email, 0, n)
+	for i := 0; i < n; i++ {
+		emails = append(emails, Email{Email: fmt.Sprintf("user%d@example.com", i)})
+	}
+	return emails
+}
+
+func TestBooks_AddEmailsBatched(t *testing.T) {
+	bookID := 1
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var lock sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	added := make(map[string]bool)
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/addressbooks/%d/emails", apiBaseUrl, bookID),
+		func(req *http.Request) (*http.Response, error) {
+			lock.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			lock.Unlock()
+
+			time.Sleep(20 * time.Millisecond)
+
+			var payload struct {
+				Emails []Email `json:"emails"`
+			}
+			body, _ := ioutil.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &payload)
+
+			lock.Lock()
+			inFlight--
+			for _, email := range payload.Emails {
+				added[email.Email] = true
+			}
+			lock.Unlock()
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Books.AddEmailsBatched(bookID, batchEmails(2500), 500, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, httpmock.GetTotalCallCount())
+	assert.Equal(t, 2500, len(added))
+	assert.True(t, maxInFlight <= 3)
+}
+
+func TestBooks_AddEmailsBatched_PartialFailure(t *testing.T) {
+	bookID := 1
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/addressbooks/%d/emails", apiBaseUrl, bookID),
+		func(req *http.Request) (*http.Response, error) {
+			var payload struct {
+				Emails []Email `json:"emails"`
+			}
+			body, _ := ioutil.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &payload)
+			if payload.Emails[0].Email == "user2@example.com" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, `{"error_code": 400, "message": "Invalid data"}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	emails := batchEmails(5)
+	err := spClient.Emails.Books.AddEmailsBatched(bookID, emails, 2, 2)
+	batchErr, ok := err.(*BatchError)
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 2}, batchErr.Succeeded)
+	assert.Equal(t, 1, len(batchErr.Failed))
+	assert.Equal(t, 1, batchErr.Failed[0].Index)
+	assert.Equal(t, emails[2:4], batchErr.Failed[0].Emails)
+	spErr, isSpError := batchErr.Failed[0].Err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.True(t, spErr.IsValidationError())
+}
+
+func TestBooks_AddEmailsBatched_Empty(t *testing.T) {
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+
+	assert.Equal(t, ErrEmptyEmails, spClient.Emails.Books.AddEmailsBatched(1, nil, 500, 3))
+}
@@ -97,3 +97,76 @@ func TestBooks_CampaignCost_Error(t *testing.T) {
 	assert.True(t, isResponseError)
 	assert.Nil(t, camp)
 }
+
+func TestBooks_CampaignCost_StringCounts(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks/1/cost",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"cur": "USD", "sent_emails_qty": "1200", "overdraftAllEmailsPrice": "15",
+			"addressesDeltaFromBalance": 0, "addressesDeltaFromTariff": "200", "max_emails_per_task": 1500, "result": true
+		}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	cost, err := spClient.Emails.Books.CampaignCost(1)
+	assert.NoError(t, err)
+	assert.Equal(t, CampaignCost{
+		Cur:                      "USD",
+		SentEmailsQty:            1200,
+		OverdraftAllEmailsPrice:  15,
+		AddressesDeltaFromTariff: 200,
+		MaxEmailsPerTask:         1500,
+		Result:                   true,
+	}, *cost)
+}
+
+func TestBooks_CampaignCost_EmptyBook(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks/1/cost",
+		httpmock.NewStringResponder(http.StatusOK, `{"cur": "USD", "sent_emails_qty": 0, "overdraftAllEmailsPrice": 0, "max_emails_per_task": 1500, "result": true}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	cost, err := spClient.Emails.Books.CampaignCost(1)
+	assert.Equal(t, ErrEmptyBook, err)
+	assert.Nil(t, cost)
+}
+
+func TestBooks_CampaignCost_NoQty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	respBody := `{"cur": "USD", "overdraftAllEmailsPrice": 0, "max_emails_per_task": 1500, "result": true}`
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks/1/cost",
+		httpmock.NewStringResponder(http.StatusOK, respBody))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	cost, err := spClient.Emails.Books.CampaignCost(1)
+	assert.Nil(t, cost)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, respBody, spErr.Body)
+}
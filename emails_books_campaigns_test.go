@@ -99,3 +99,22 @@ func TestBooks_Campaigns_Error(t *testing.T) {
 	_, isResponseError := err.(*SendpulseError)
 	assert.True(t, isResponseError)
 }
+
+func TestBooks_Campaigns_NeverUsed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	bookID := 1
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	for _, respBody := range []string{`[]`, `{}`} {
+		httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/campaigns?limit=10&offset=20", apiBaseUrl, bookID),
+			httpmock.NewStringResponder(http.StatusOK, respBody))
+
+		campaignsList, err := spClient.Emails.Books.Campaigns(bookID, 10, 20)
+		assert.NoError(t, err)
+		assert.NotNil(t, campaignsList)
+		assert.Equal(t, 0, len(campaignsList))
+	}
+}
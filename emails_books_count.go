@@ -0,0 +1,92 @@
+package sendpulse
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const booksCountPageSize = 100
+
+// booksTotalTTL is how long ListPage reuses the counted number of books instead of counting them again for every page
+const booksTotalTTL = time.Minute
+
+// booksTotalCache keeps the number of books counted for ListPage. Creating or deleting a book through the client
+// resets it, books added or removed elsewhere are seen once it expires
+type booksTotalCache struct {
+	lock    sync.Mutex
+	total   int
+	expires time.Time
+}
+
+func (cache *booksTotalCache) get() (int, bool) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	if cache.expires.IsZero() || time.Now().After(cache.expires) {
+		return 0, false
+	}
+	return cache.total, true
+}
+
+func (cache *booksTotalCache) set(total int) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	cache.total = total
+	cache.expires = time.Now().Add(booksTotalTTL)
+}
+
+func (cache *booksTotalCache) reset() {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	cache.expires = time.Time{}
+}
+
+// Count returns the number of address books of the account.
+// The list response has no total, so the books are listed by 100 until the last page (a request per 100 books)
+func (b *books) Count() (int, error) {
+	return b.CountContext(context.Background())
+}
+
+func (b *books) CountContext(ctx context.Context) (int, error) {
+	total := 0
+	for offset := 0; ; offset += booksCountPageSize {
+		page, err := b.ListContext(ctx, booksCountPageSize, offset)
+		if err != nil {
+			return 0, err
+		}
+		total += len(page)
+		if len(page) < booksCountPageSize {
+			b.Client.booksTotal.set(total)
+			return total, nil
+		}
+	}
+}
+
+// ListPage returns a page of address books with the total number of books, e.g. to show "page X of Y".
+// Like the other ...Page methods it returns the total along the items. For address books it's known right away
+// from the last page; otherwise it's counted with Count and reused for the next pages for booksTotalTTL
+func (b *books) ListPage(limit int, offset int) ([]Book, int, error) {
+	return b.ListPageContext(context.Background(), limit, offset)
+}
+
+func (b *books) ListPageContext(ctx context.Context, limit int, offset int) ([]Book, int, error) {
+	page, err := b.ListContext(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(page) < limit && (len(page) != 0 || offset == 0) {
+		total := offset + len(page)
+		b.Client.booksTotal.set(total)
+		return page, total, nil
+	}
+	if total, ok := b.Client.booksTotal.get(); ok {
+		return page, total, nil
+	}
+
+	total, err := b.CountContext(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return page, total, nil
+}
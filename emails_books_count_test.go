@@ -0,0 +1,95 @@
+package sendpulse
+
+import (
+	"encoding/json"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func registerBooksPages(total int) {
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		func(req *http.Request) (*http.Response, error) {
+			limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+			offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+
+			page := make([]map[string]interface{}, 0)
+			for id := offset + 1; id <= offset+limit && id <= total; id++ {
+				page = append(page, map[string]interface{}{"id": id, "name": "Book " + strconv.Itoa(id)})
+			}
+			encoded, _ := json.Marshal(page)
+			return httpmock.NewStringResponse(http.StatusOK, string(encoded)), nil
+		})
+}
+
+func TestBooks_Count(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerBooksPages(230)
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	total, err := spClient.Emails.Books.Count()
+	assert.NoError(t, err)
+	assert.Equal(t, 230, total)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestBooks_ListPage(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerBooksPages(42)
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	books, total, err := spClient.Emails.Books.ListPage(10, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, 42, total)
+	assert.Equal(t, 10, len(books))
+	assert.Equal(t, 21, books[0].ID)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+
+	books, total, err = spClient.Emails.Books.ListPage(10, 30)
+	assert.NoError(t, err)
+	assert.Equal(t, 42, total)
+	assert.Equal(t, 10, len(books))
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestBooks_ListPage_LastPage(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerBooksPages(42)
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	books, total, err := spClient.Emails.Books.ListPage(10, 40)
+	assert.NoError(t, err)
+	assert.Equal(t, 42, total)
+	assert.Equal(t, 2, len(books))
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestBooks_ListPage_ResetByDelete(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerBooksPages(42)
+	httpmock.RegisterResponder("DELETE", apiBaseUrl+"/addressbooks/1",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	_, _, err := spClient.Emails.Books.ListPage(10, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, spClient.Emails.Books.Delete(1))
+	_, _, err = spClient.Emails.Books.ListPage(10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, httpmock.GetTotalCallCount())
+}
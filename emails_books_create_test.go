@@ -39,7 +39,7 @@ func TestBooks_Create_IncorrectJson(t *testing.T) {
 	assert.Nil(t, bookId)
 
 	assert.Equal(t, http.StatusOK, spErr.HttpCode)
-	assert.Equal(t, path, spErr.Url)
+	assert.Equal(t, apiBaseUrl+path, spErr.Url)
 	assert.Equal(t, respBody, spErr.Body)
 }
 
@@ -74,7 +74,7 @@ func TestBooks_Create_NoIdInResponse(t *testing.T) {
 	assert.Nil(t, bookId)
 
 	assert.Equal(t, http.StatusOK, httpErr.HttpCode)
-	assert.Equal(t, path, httpErr.Url)
+	assert.Equal(t, apiBaseUrl+path, httpErr.Url)
 	assert.Equal(t, respBody, httpErr.Body)
 }
 
@@ -138,3 +138,23 @@ func TestBooks_Create_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, newBookId, *bookId)
 }
+
+func TestBooks_Create_StringID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": "12345678"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	bookId, err := spClient.Emails.Books.Create(fake.Word())
+	assert.NoError(t, err)
+	assert.Equal(t, 12345678, *bookId)
+}
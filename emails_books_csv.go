@@ -0,0 +1,206 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"strconv"
+	"strings"
+)
+
+// importBatchSize is the number of contacts sent per request by ImportEmailsFromCSV
+const importBatchSize = 500
+
+// ImportSkippedRow is a CSV row ImportEmailsFromCSV didn't send, Line is 1-based and counts the header
+type ImportSkippedRow struct {
+	Line   int
+	Reason string
+}
+
+type ImportResult struct {
+	// Processed is the number of data rows read from the CSV
+	Processed int
+	// Imported is the number of contacts in the batches SendPulse accepted
+	Imported int
+	Skipped  []ImportSkippedRow
+}
+
+// ImportError is returned by ImportEmailsFromCSV when some batches were rejected. The rest of the batches are still sent
+type ImportError struct {
+	FailedContacts int
+	Errors         []error
+}
+
+func (e *ImportError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Sprintf("%d contacts weren't imported: %s", e.FailedContacts, strings.Join(messages, "; "))
+}
+
+// ImportEmailsFromCSV adds the contacts read from r to the address book sending them by 500 per request.
+// columnMap maps CSV columns to contact fields: "email" for the email and any other value for a variable name.
+// Columns are identified by their header names if hasHeader is set and by 0-based indexes ("0", "1", ...) otherwise,
+// unmapped columns are ignored. Rows without a valid email are skipped and listed in the result.
+// If some batches fail, the result is returned together with *ImportError
+func (b *books) ImportEmailsFromCSV(addressBookId int, r io.Reader, hasHeader bool, columnMap map[string]string) (*ImportResult, error) {
+	return b.ImportEmailsFromCSVContext(context.Background(), addressBookId, r, hasHeader, columnMap)
+}
+
+func (b *books) ImportEmailsFromCSVContext(ctx context.Context, addressBookId int, r io.Reader, hasHeader bool, columnMap map[string]string) (*ImportResult, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	line := 0
+	// fields maps column indexes to contact fields
+	fields := make(map[int]string)
+	if hasHeader {
+		header, err := reader.Read()
+		if err != nil {
+			return nil, fmt.Errorf("could not read CSV header: %w", err)
+		}
+		line++
+		for i, name := range header {
+			if field, ok := columnMap[strings.TrimSpace(name)]; ok {
+				fields[i] = field
+			}
+		}
+	} else {
+		for column, field := range columnMap {
+			i, err := strconv.Atoi(column)
+			if err != nil {
+				return nil, fmt.Errorf("invalid column index %q", column)
+			}
+			fields[i] = field
+		}
+	}
+
+	emailColumn := -1
+	for i, field := range fields {
+		if field == "email" {
+			emailColumn = i
+		}
+	}
+	if emailColumn < 0 {
+		return nil, errors.New("no column is mapped to email")
+	}
+
+	result := &ImportResult{Skipped: make([]ImportSkippedRow, 0)}
+	importErr := &ImportError{}
+	batch := make([]Email, 0, importBatchSize)
+
+	sendBatch := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := b.AddEmailsContext(ctx, addressBookId, batch, nil, ""); err != nil {
+			if ctx.Err() != nil {
+				return err
+			}
+			importErr.FailedContacts += len(batch)
+			importErr.Errors = append(importErr.Errors, err)
+		} else {
+			result.Imported += len(batch)
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return result, fmt.Errorf("could not read CSV: %w", err)
+			}
+			result.Processed++
+			result.Skipped = append(result.Skipped, ImportSkippedRow{Line: line, Reason: parseErr.Err.Error()})
+			continue
+		}
+		result.Processed++
+
+		if emailColumn >= len(record) {
+			result.Skipped = append(result.Skipped, ImportSkippedRow{Line: line, Reason: "missing email"})
+			continue
+		}
+		email := strings.TrimSpace(record[emailColumn])
+		if email == "" {
+			result.Skipped = append(result.Skipped, ImportSkippedRow{Line: line, Reason: "missing email"})
+			continue
+		}
+		if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+			result.Skipped = append(result.Skipped, ImportSkippedRow{Line: line, Reason: fmt.Sprintf("invalid email %q", email)})
+			continue
+		}
+
+		contact := Email{Email: email, Variables: make(map[string]interface{})}
+		for i, field := range fields {
+			if i == emailColumn || i >= len(record) {
+				continue
+			}
+			contact.Variables[field] = record[i]
+		}
+		batch = append(batch, contact)
+
+		if len(batch) == importBatchSize {
+			if err := sendBatch(); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	if err := sendBatch(); err != nil {
+		return result, err
+	}
+	if len(importErr.Errors) > 0 {
+		return result, importErr
+	}
+	return result, nil
+}
+
+// exportBatchSize is the number of contacts requested per call by ExportEmailsToCSV
+const exportBatchSize = 500
+
+// ExportEmailsToCSV writes the contacts of the address book to w as CSV: a header row with "email" and the columns,
+// then a row per contact with its email and the values of the variables named by columns.
+// Contacts are requested page by page and written as they come, so the book isn't held in memory.
+// Variables a contact doesn't have are written as empty cells
+func (b *books) ExportEmailsToCSV(addressBookId int, w io.Writer, columns []string) error {
+	return b.ExportEmailsToCSVContext(context.Background(), addressBookId, w, columns)
+}
+
+func (b *books) ExportEmailsToCSVContext(ctx context.Context, addressBookId int, w io.Writer, columns []string) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(append([]string{"email"}, columns...)); err != nil {
+		return err
+	}
+
+	err := b.IterateEmailsContext(ctx, addressBookId, exportBatchSize, func(contact Contact) error {
+		values := make(map[string]string, len(contact.Variables))
+		for _, variable := range contact.Variables {
+			values[variable.Name] = toString(variable.Value)
+		}
+
+		row := make([]string, 0, len(columns)+1)
+		row = append(row, contact.Email)
+		for _, column := range columns {
+			row = append(row, values[column])
+		}
+		return writer.Write(row)
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/icrowley/fake"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
 	"net/http"
 	"testing"
 )
@@ -132,3 +133,33 @@ func TestBooks_DeleteEmails_Success(t *testing.T) {
 
 	assert.NoError(t, spClient.Emails.Books.DeleteEmails(bookId, []string{fake.EmailAddress(), fake.EmailAddress()}))
 }
+
+func TestBooks_DeleteEmails_RequestBody(t *testing.T) {
+	var bookId int = 1
+	apiUid := fake.CharactersN(50)
+	apiSecret := fake.CharactersN(50)
+
+	url := fmt.Sprintf("%s/addressbooks/%d/emails", apiBaseUrl, bookId)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("DELETE", url,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  apiUid,
+		Secret:  apiSecret,
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	assert.NoError(t, spClient.Emails.Books.DeleteEmails(bookId, []string{"alice@example.com", "bob@example.com"}))
+	assert.JSONEq(t, `{"emails": ["alice@example.com", "bob@example.com"]}`, requestBody)
+}
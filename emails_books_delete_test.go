@@ -132,3 +132,27 @@ func TestBooks_Delete_Success(t *testing.T) {
 
 	assert.NoError(t, spClient.Emails.Books.Delete(bookId))
 }
+
+func TestBooks_Delete_NoContent(t *testing.T) {
+	var bookId int = 1
+	apiUid := fake.CharactersN(50)
+	apiSecret := fake.CharactersN(50)
+
+	url := fmt.Sprintf("%s/addressbooks/%d", apiBaseUrl, bookId)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("DELETE", url,
+		httpmock.NewStringResponder(http.StatusNoContent, ""))
+
+	config := Config{
+		UserID:  apiUid,
+		Secret:  apiSecret,
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	assert.NoError(t, spClient.Emails.Books.Delete(bookId))
+}
@@ -0,0 +1,100 @@
+package sendpulse
+
+import (
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestBooks_EmailGlobalInfo_Success(t *testing.T) {
+	email := "john@example.com"
+	url := fmt.Sprintf("%s/emails/john@example.com", apiBaseUrl)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	respBody := `[
+		{
+			"book_id": "10",
+			"email": "john@example.com",
+			"status": "1",
+			"variables": [{"name": "name", "type": "string", "value": "John"}]
+		},
+		{
+			"book_id": 20,
+			"email": "john@example.com",
+			"status": 4,
+			"variables": []
+		}
+	]`
+
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(http.StatusOK, respBody))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	contacts, err := spClient.Emails.Books.EmailGlobalInfo(email)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]Contact{
+		10: {Email: email, Status: 1, Variables: []Variable{{Name: "name", Type: "string", Value: "John"}}},
+		20: {Email: email, Status: 4, Variables: []Variable{}},
+	}, contacts)
+}
+
+func TestBooks_EmailGlobalInfo_NotFound(t *testing.T) {
+	email := "john@example.com"
+	url := fmt.Sprintf("%s/emails/john@example.com", apiBaseUrl)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	contacts, err := spClient.Emails.Books.EmailGlobalInfo(email)
+	assert.Equal(t, ErrEmailNotFound, err)
+	assert.Nil(t, contacts)
+}
+
+func TestBooks_EmailGlobalInfo_Error(t *testing.T) {
+	email := "john@example.com"
+	url := fmt.Sprintf("%s/emails/john@example.com", apiBaseUrl)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(http.StatusInternalServerError, ""))
+
+	config := Config{
+		UserID:      fake.CharactersN(50),
+		Secret:      fake.CharactersN(50),
+		Timeout:     5,
+		MaxAttempts: 1,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	contacts, err := spClient.Emails.Books.EmailGlobalInfo(email)
+	assert.Error(t, err)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+	assert.Nil(t, contacts)
+}
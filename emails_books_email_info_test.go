@@ -0,0 +1,129 @@
+package sendpulse
+
+import (
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestBooks_EmailInfo_Success(t *testing.T) {
+	var bookId int = 1
+	email := "john+promo@example.com"
+
+	url := fmt.Sprintf("%s/addressbooks/%d/emails/john+promo@example.com", apiBaseUrl, bookId)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	respBody := `{
+		"email": "john+promo@example.com",
+		"abook_id": "1",
+		"status": "1",
+		"status_explain": "Active",
+		"variables": [
+			{"name": "name", "type": "string", "value": "John"}
+		]
+	}`
+
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(http.StatusOK, respBody))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	contact, err := spClient.Emails.Books.EmailInfo(bookId, email)
+	assert.NoError(t, err)
+	assert.Equal(t, Contact{
+		Email:         email,
+		Status:        1,
+		StatusExplain: "Active",
+		Variables:     []Variable{{Name: "name", Type: "string", Value: "John"}},
+	}, *contact)
+}
+
+func TestBooks_EmailInfo_NotFound(t *testing.T) {
+	var bookId int = 1
+	email := "john@example.com"
+
+	url := fmt.Sprintf("%s/addressbooks/%d/emails/john@example.com", apiBaseUrl, bookId)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(http.StatusNotFound, `{"is_error": true, "message": "Email not found", "error_code": 404}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	contact, err := spClient.Emails.Books.EmailInfo(bookId, email)
+	assert.Equal(t, ErrEmailNotFound, err)
+	assert.Nil(t, contact)
+}
+
+func TestBooks_EmailInfo_BadJson(t *testing.T) {
+	var bookId int = 1
+	email := "john@example.com"
+
+	url := fmt.Sprintf("%s/addressbooks/%d/emails/john@example.com", apiBaseUrl, bookId)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(http.StatusOK, `Invalid json`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	contact, err := spClient.Emails.Books.EmailInfo(bookId, email)
+	assert.Error(t, err)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+	assert.Nil(t, contact)
+}
+
+func TestBooks_EmailInfo_Error(t *testing.T) {
+	var bookId int = 1
+	email := "john@example.com"
+
+	url := fmt.Sprintf("%s/addressbooks/%d/emails/john@example.com", apiBaseUrl, bookId)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(http.StatusBadRequest, ""))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	contact, err := spClient.Emails.Books.EmailInfo(bookId, email)
+	assert.Error(t, err)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+	assert.Nil(t, contact)
+}
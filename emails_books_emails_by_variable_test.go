@@ -0,0 +1,94 @@
+package sendpulse
+
+import (
+	"errors"
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBooks_EmailsByVariable_String(t *testing.T) {
+	bookID := 2128929
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/variables/plan/premium", apiBaseUrl, bookID),
+		httpmock.NewStringResponder(http.StatusOK, `[
+			{"email": "john@example.com", "status": 0, "status_explain": "Active", "variables": [{"name": "plan", "type": "string", "value": "premium"}]},
+			{"email": "jane@example.com", "status": "0", "status_explain": "Active"}
+		]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	contacts, err := spClient.Emails.Books.EmailsByVariable(bookID, "plan", "premium")
+	assert.NoError(t, err)
+	assert.Equal(t, []Contact{
+		{Email: "john@example.com", Status: 0, StatusExplain: "Active", Variables: []Variable{{Name: "plan", Type: "string", Value: "premium"}}},
+		{Email: "jane@example.com", Status: 0, StatusExplain: "Active"},
+	}, contacts)
+}
+
+func TestBooks_EmailsByVariable_Number(t *testing.T) {
+	bookID := 2128929
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/variables/age/30", apiBaseUrl, bookID),
+		httpmock.NewStringResponder(http.StatusOK, `[{"email": "john@example.com", "status": 0}]`))
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/variables/birthday/1990-05-17", apiBaseUrl, bookID),
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	contacts, err := spClient.Emails.Books.EmailsByVariable(bookID, "age", 30)
+	assert.NoError(t, err)
+	assert.Equal(t, []Contact{{Email: "john@example.com"}}, contacts)
+
+	contacts, err = spClient.Emails.Books.EmailsByVariable(bookID, "birthday", time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, []Contact{}, contacts)
+}
+
+func TestBooks_EmailsByVariable_NotFound(t *testing.T) {
+	bookID := 2128929
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/variables/plan/premium", apiBaseUrl, bookID),
+		httpmock.NewStringResponder(http.StatusNotFound, `{"error_code": 404, "message": "Variable not found"}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	contacts, err := spClient.Emails.Books.EmailsByVariable(bookID, "plan", "premium")
+	assert.Nil(t, contacts)
+	assert.True(t, errors.Is(err, ErrVariableNotFound))
+}
+
+func TestBooks_EmailsByVariable_BadJson(t *testing.T) {
+	bookID := 2128929
+	path := fmt.Sprintf("/addressbooks/%d/variables/plan/premium", bookID)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+path,
+		httpmock.NewStringResponder(http.StatusOK, `Invalid json`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Emails.Books.EmailsByVariable(bookID, "plan", "premium")
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, apiBaseUrl+path, spErr.Url)
+}
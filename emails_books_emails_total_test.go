@@ -131,3 +131,22 @@ func TestBooks_Emails_Total_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTotal, total)
 }
+
+func TestBooks_Emails_Total_NotNumber(t *testing.T) {
+	bookID := 1
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/emails/total", apiBaseUrl, bookID),
+		httpmock.NewStringResponder(http.StatusOK, `{"total": true}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	assert.NotPanics(t, func() {
+		_, err := spClient.Emails.Books.EmailsTotal(bookID)
+		_, isSpError := err.(*SendpulseError)
+		assert.True(t, isSpError)
+	})
+}
@@ -0,0 +1,57 @@
+package sendpulse
+
+import (
+	"bytes"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestBooks_ExportEmailsToCSV_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerEmailsPages(1, []Contact{
+		{Email: "john@example.com", Variables: []Variable{{Name: "name", Value: "John"}, {Name: "age", Value: 42}}},
+		{Email: "jane@example.com", Variables: []Variable{{Name: "age", Value: 35}}},
+		{Email: "bob@example.com", Variables: []Variable{{Name: "name", Value: "Bob, Jr."}, {Name: "city", Value: "Kyiv"}}},
+	})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	var buf bytes.Buffer
+	err := spClient.Emails.Books.ExportEmailsToCSV(1, &buf, []string{"name", "age"})
+	assert.NoError(t, err)
+	assert.Equal(t, "email,name,age\n"+
+		"john@example.com,John,42\n"+
+		"jane@example.com,,35\n"+
+		"bob@example.com,\"Bob, Jr.\",\n", buf.String())
+}
+
+func TestBooks_ExportEmailsToCSV_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks/1/emails",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"error_code": 213, "message": "Book not found"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	var buf bytes.Buffer
+	err := spClient.Emails.Books.ExportEmailsToCSV(1, &buf, []string{"name"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,90 @@
+package sendpulse
+
+import (
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestBooks_Find_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks?limit=100&offset=0",
+		httpmock.NewStringResponder(http.StatusOK, `[
+			{"id": 1, "name": "Customers 2020"},
+			{"id": 2, "name": "Partners"},
+			{"id": 3, "name": "VIP customers"}
+		]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	books, err := spClient.Emails.Books.Find("CUSTOMERS", 0, 0)
+	assert.NoError(t, err)
+	assert.Len(t, books, 2)
+	assert.Equal(t, 1, books[0].ID)
+	assert.Equal(t, 3, books[1].ID)
+}
+
+func TestBooks_Find_Paging(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var firstPage, secondPage string
+	for i := 0; i < 100; i++ {
+		if i > 0 {
+			firstPage += ","
+		}
+		firstPage += fmt.Sprintf(`{"id": %d, "name": "Book %d"}`, i+1, i+1)
+	}
+	secondPage = `{"id": 101, "name": "Shop customers"}, {"id": 102, "name": "Blog customers"}`
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks?limit=100&offset=0",
+		httpmock.NewStringResponder(http.StatusOK, "["+firstPage+"]"))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks?limit=100&offset=100",
+		httpmock.NewStringResponder(http.StatusOK, "["+secondPage+"]"))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	books, err := spClient.Emails.Books.Find("customers", 1, 1)
+	assert.NoError(t, err)
+	assert.Len(t, books, 1)
+	assert.Equal(t, 102, books[0].ID)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestBooks_Find_NothingFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks?limit=100&offset=0",
+		httpmock.NewStringResponder(http.StatusOK, `[{"id": 1, "name": "Partners"}]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	books, err := spClient.Emails.Books.Find("customers", 10, 0)
+	assert.NoError(t, err)
+	assert.NotNil(t, books)
+	assert.Len(t, books, 0)
+}
@@ -1,13 +1,16 @@
 package sendpulse
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/icrowley/fake"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/jarcoal/httpmock.v1"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestBooks_Get_Success(t *testing.T) {
@@ -106,3 +109,62 @@ func TestBooks_Get_Error(t *testing.T) {
 	_, isResponseError := err.(*SendpulseError)
 	assert.True(t, isResponseError)
 }
+
+func TestBooks_GetContext_DeadlineExceeded(t *testing.T) {
+	apiUid := fake.CharactersN(50)
+	apiSecret := fake.CharactersN(50)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d", apiBaseUrl, 1),
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	config := Config{
+		UserID:  apiUid,
+		Secret:  apiSecret,
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	ctx, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+
+	book, err := spClient.Emails.Books.GetContext(ctx, 1)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Nil(t, book)
+}
+
+func TestBooks_Get_NotFound(t *testing.T) {
+	notExistingBookID := 1
+
+	path := fmt.Sprintf("/addressbooks/%d", notExistingBookID)
+	url := apiBaseUrl + path
+
+	apiUid := fake.CharactersN(50)
+	apiSecret := fake.CharactersN(50)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	config := Config{
+		UserID:  apiUid,
+		Secret:  apiSecret,
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	book, err := spClient.Emails.Books.Get(notExistingBookID)
+	assert.Error(t, err)
+	assert.Nil(t, book)
+	spErr, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+	assert.Equal(t, "GET", spErr.Method)
+	assert.Equal(t, apiBaseUrl+path, spErr.Url)
+}
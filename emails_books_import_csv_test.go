@@ -0,0 +1,141 @@
+package sendpulse
+
+import (
+	"errors"
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBooks_ImportEmailsFromCSV_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/addressbooks/1/emails",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	csvData := "E-mail,Full name,City\n" +
+		"john@example.com,John Doe,Kyiv\n" +
+		"not-an-email,Broken Row,Lviv\n" +
+		"jane@example.com,Jane Roe,Odesa\n" +
+		",No Email,Dnipro\n"
+
+	result, err := spClient.Emails.Books.ImportEmailsFromCSV(1, strings.NewReader(csvData), true, map[string]string{
+		"E-mail":    "email",
+		"Full name": "name",
+		"City":      "city",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, result.Processed)
+	assert.Equal(t, 2, result.Imported)
+	assert.Equal(t, []ImportSkippedRow{
+		{Line: 3, Reason: `invalid email "not-an-email"`},
+		{Line: 5, Reason: "missing email"},
+	}, result.Skipped)
+	assert.JSONEq(t, `{"emails": [
+		{"email": "john@example.com", "variables": {"name": "John Doe", "city": "Kyiv"}},
+		{"email": "jane@example.com", "variables": {"name": "Jane Roe", "city": "Odesa"}}
+	]}`, requestBody)
+}
+
+func TestBooks_ImportEmailsFromCSV_NoHeader(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/addressbooks/1/emails",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	csvData := "John,john@example.com\n\"Broken,\"quote\",x@example.com\n"
+
+	result, err := spClient.Emails.Books.ImportEmailsFromCSV(1, strings.NewReader(csvData), false, map[string]string{
+		"0": "name",
+		"1": "email",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Processed)
+	assert.Equal(t, 1, result.Imported)
+	assert.Len(t, result.Skipped, 1)
+	assert.Equal(t, 2, result.Skipped[0].Line)
+	assert.JSONEq(t, `{"emails": [{"email": "john@example.com", "variables": {"name": "John"}}]}`, requestBody)
+}
+
+func TestBooks_ImportEmailsFromCSV_FailedBatch(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	calls := 0
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/addressbooks/1/emails",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 1 {
+				return httpmock.NewStringResponse(http.StatusBadRequest, `{"error_code": 400, "message": "Invalid data"}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	var csvData strings.Builder
+	for i := 0; i < 502; i++ {
+		csvData.WriteString(fmt.Sprintf("user%d@example.com\n", i))
+	}
+
+	result, err := spClient.Emails.Books.ImportEmailsFromCSV(1, strings.NewReader(csvData.String()), false, map[string]string{"0": "email"})
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 502, result.Processed)
+	assert.Equal(t, 2, result.Imported)
+
+	var importErr *ImportError
+	assert.True(t, errors.As(err, &importErr))
+	assert.Equal(t, 500, importErr.FailedContacts)
+	assert.Len(t, importErr.Errors, 1)
+}
+
+func TestBooks_ImportEmailsFromCSV_NoEmailColumn(t *testing.T) {
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+
+	_, err := spClient.Emails.Books.ImportEmailsFromCSV(1, strings.NewReader("a,b\n"), true, map[string]string{"a": "name"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,145 @@
+package sendpulse
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func registerEmailsPages(bookId int, contacts []Contact) {
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/emails", apiBaseUrl, bookId),
+		func(req *http.Request) (*http.Response, error) {
+			limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+			offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+			page := []Contact{}
+			for i := offset; i < len(contacts) && i < offset+limit; i++ {
+				page = append(page, contacts[i])
+			}
+			encoded, _ := json.Marshal(page)
+			return httpmock.NewBytesResponse(http.StatusOK, encoded), nil
+		})
+}
+
+func TestBooks_IterateEmails_Success(t *testing.T) {
+	var bookId int = 1
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var contacts []Contact
+	for i := 0; i < 5; i++ {
+		contacts = append(contacts, Contact{Email: fake.EmailAddress(), Status: 1, StatusExplain: "Active"})
+	}
+	registerEmailsPages(bookId, contacts)
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	var iterated []Contact
+	err := spClient.Emails.Books.IterateEmails(bookId, 2, func(contact Contact) error {
+		iterated = append(iterated, contact)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, contacts, iterated)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestBooks_IterateEmails_Stop(t *testing.T) {
+	var bookId int = 1
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var contacts []Contact
+	for i := 0; i < 5; i++ {
+		contacts = append(contacts, Contact{Email: fake.EmailAddress()})
+	}
+	registerEmailsPages(bookId, contacts)
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	stopErr := errors.New("stop")
+	calls := 0
+	err := spClient.Emails.Books.IterateEmails(bookId, 2, func(contact Contact) error {
+		calls++
+		if calls == 3 {
+			return stopErr
+		}
+		return nil
+	})
+	assert.Equal(t, stopErr, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestBooks_IterateEmails_EmptyBook(t *testing.T) {
+	var bookId int = 1
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerEmailsPages(bookId, nil)
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	calls := 0
+	err := spClient.Emails.Books.IterateEmails(bookId, 100, func(contact Contact) error {
+		calls++
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, calls)
+}
+
+func TestBooks_IterateEmails_Error(t *testing.T) {
+	var bookId int = 1
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/emails", apiBaseUrl, bookId),
+		httpmock.NewStringResponder(http.StatusBadRequest, ""))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Books.IterateEmails(bookId, 100, func(contact Contact) error {
+		return nil
+	})
+	assert.Error(t, err)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+
+	assert.Error(t, spClient.Emails.Books.IterateEmails(bookId, 0, func(contact Contact) error {
+		return nil
+	}))
+}
@@ -0,0 +1,67 @@
+package sendpulse
+
+import (
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestBooks_Segments(t *testing.T) {
+	bookID := 2128929
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/segments", apiBaseUrl, bookID),
+		httpmock.NewStringResponder(http.StatusOK, `[{"id": 5, "name": "US customers"}, {"id": "6", "name": "VIP", "book_id": 2128929}]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	segments, err := spClient.Emails.Books.Segments(bookID)
+	assert.NoError(t, err)
+	assert.Equal(t, []Segment{
+		{ID: 5, Name: "US customers", BookID: bookID},
+		{ID: 6, Name: "VIP", BookID: bookID},
+	}, segments)
+}
+
+func TestBooks_Segments_Empty(t *testing.T) {
+	bookID := 2128929
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/segments", apiBaseUrl, bookID),
+		httpmock.NewStringResponder(http.StatusOK, `{}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	segments, err := spClient.Emails.Books.Segments(bookID)
+	assert.NoError(t, err)
+	assert.Equal(t, []Segment{}, segments)
+}
+
+func TestBooks_Segments_BadJson(t *testing.T) {
+	bookID := 2128929
+	path := fmt.Sprintf("/addressbooks/%d/segments", bookID)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+path,
+		httpmock.NewStringResponder(http.StatusOK, `Invalid json`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	segments, err := spClient.Emails.Books.Segments(bookID)
+	assert.Nil(t, segments)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, apiBaseUrl+path, spErr.Url)
+}
@@ -0,0 +1,115 @@
+package sendpulse
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TransferError is returned by CopyEmails and MoveEmails when some emails didn't make it.
+// Failed are the emails not added to the target book with the reasons, NotRemoved the moved emails
+// added to the target book but still in the source one
+type TransferError struct {
+	Failed     map[string]error
+	NotRemoved []string
+}
+
+func (e *TransferError) Error() string {
+	emails := make([]string, 0, len(e.Failed))
+	for email := range e.Failed {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	messages := make([]string, 0, len(emails))
+	for _, email := range emails {
+		messages = append(messages, fmt.Sprintf("%s: %s", email, e.Failed[email].Error()))
+	}
+	return fmt.Sprintf("%d emails weren't copied, %d weren't removed from the source book: %s",
+		len(e.Failed), len(e.NotRemoved), strings.Join(messages, "; "))
+}
+
+// CopyEmails adds the emails of the source address book to the target one together with their variables.
+// SendPulse has no endpoint for it, so every email is read from the source book and then they are all added at once
+func (b *books) CopyEmails(fromBookID int, toBookID int, emailsList []string) error {
+	return b.CopyEmailsContext(context.Background(), fromBookID, toBookID, emailsList)
+}
+
+func (b *books) CopyEmailsContext(ctx context.Context, fromBookID int, toBookID int, emailsList []string) error {
+	_, err := b.copyEmails(ctx, fromBookID, toBookID, emailsList)
+	return err
+}
+
+// MoveEmails copies the emails to the target address book and deletes the copied ones from the source book.
+// It isn't atomic: if deleting fails the emails stay in both books and are listed in TransferError.NotRemoved
+func (b *books) MoveEmails(fromBookID int, toBookID int, emailsList []string) error {
+	return b.MoveEmailsContext(context.Background(), fromBookID, toBookID, emailsList)
+}
+
+func (b *books) MoveEmailsContext(ctx context.Context, fromBookID int, toBookID int, emailsList []string) error {
+	copied, err := b.copyEmails(ctx, fromBookID, toBookID, emailsList)
+	transferErr, partial := err.(*TransferError)
+	if err != nil && !partial {
+		return err
+	}
+	if len(copied) == 0 {
+		return err
+	}
+
+	if err := b.DeleteEmailsContext(ctx, fromBookID, copied); err != nil {
+		if transferErr == nil {
+			transferErr = &TransferError{Failed: make(map[string]error)}
+		}
+		transferErr.NotRemoved = copied
+	}
+	if transferErr != nil {
+		return transferErr
+	}
+	return nil
+}
+
+// copyEmails returns the emails added to the target book. Emails that couldn't be read from the source book
+// are reported in *TransferError while the rest are still copied
+func (b *books) copyEmails(ctx context.Context, fromBookID int, toBookID int, emailsList []string) ([]string, error) {
+	if len(emailsList) == 0 {
+		return nil, ErrEmptyEmails
+	}
+
+	transferErr := &TransferError{Failed: make(map[string]error)}
+	contacts := make([]Email, 0, len(emailsList))
+	for _, email := range emailsList {
+		contact, err := b.EmailInfoContext(ctx, fromBookID, email)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			transferErr.Failed[email] = err
+			continue
+		}
+
+		variables := make(map[string]interface{})
+		for _, variable := range contact.Variables {
+			variables[variable.Name] = variable.Value
+		}
+		contacts = append(contacts, Email{Email: contact.Email, Variables: variables})
+	}
+
+	copied := make([]string, 0, len(contacts))
+	if len(contacts) != 0 {
+		if err := b.AddEmailsContext(ctx, toBookID, contacts, nil, ""); err != nil {
+			for _, contact := range contacts {
+				transferErr.Failed[contact.Email] = err
+			}
+		} else {
+			for _, contact := range contacts {
+				copied = append(copied, contact.Email)
+			}
+		}
+	}
+
+	if len(transferErr.Failed) != 0 {
+		return copied, transferErr
+	}
+	return copied, nil
+}
@@ -0,0 +1,107 @@
+package sendpulse
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestBooks_MoveEmails(t *testing.T) {
+	fromBookID, toBookID := 1, 2
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var calls []string
+	record := func(req *http.Request, respBody string) (*http.Response, error) {
+		var body []byte
+		if req.Body != nil {
+			body, _ = ioutil.ReadAll(req.Body)
+		}
+		calls = append(calls, req.Method+" "+req.URL.Path+" "+string(body))
+		return httpmock.NewStringResponse(http.StatusOK, respBody), nil
+	}
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/emails/alice@example.com", apiBaseUrl, fromBookID),
+		func(req *http.Request) (*http.Response, error) {
+			return record(req, `{"email": "alice@example.com", "status": 1, "variables": [{"name": "plan", "type": "string", "value": "premium"}]}`)
+		})
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/emails/ghost@example.com", apiBaseUrl, fromBookID),
+		httpmock.NewStringResponder(http.StatusNotFound, `{"error_code": 404, "message": "Not found"}`))
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/addressbooks/%d/emails", apiBaseUrl, toBookID),
+		func(req *http.Request) (*http.Response, error) {
+			return record(req, `{"result": true}`)
+		})
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/addressbooks/%d/emails", apiBaseUrl, fromBookID),
+		func(req *http.Request) (*http.Response, error) {
+			return record(req, `{"result": true}`)
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Books.MoveEmails(fromBookID, toBookID, []string{"alice@example.com", "ghost@example.com"})
+	transferErr, ok := err.(*TransferError)
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(transferErr.Failed))
+	assert.Equal(t, ErrEmailNotFound, transferErr.Failed["ghost@example.com"])
+	assert.Equal(t, 0, len(transferErr.NotRemoved))
+
+	assert.Equal(t, 3, len(calls))
+	assert.Equal(t, fmt.Sprintf("GET /addressbooks/%d/emails/alice@example.com ", fromBookID), calls[0])
+
+	var added struct {
+		Emails []Email `json:"emails"`
+	}
+	_ = json.Unmarshal([]byte(calls[1][len(fmt.Sprintf("POST /addressbooks/%d/emails ", toBookID)):]), &added)
+	assert.Equal(t, []Email{{Email: "alice@example.com", Variables: map[string]interface{}{"plan": "premium"}}}, added.Emails)
+
+	assert.Equal(t, fmt.Sprintf(`DELETE /addressbooks/%d/emails {"emails":["alice@example.com"]}`, fromBookID), calls[2])
+}
+
+func TestBooks_MoveEmails_DeleteFailed(t *testing.T) {
+	fromBookID, toBookID := 1, 2
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/emails/alice@example.com", apiBaseUrl, fromBookID),
+		httpmock.NewStringResponder(http.StatusOK, `{"email": "alice@example.com", "status": 1}`))
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/addressbooks/%d/emails", apiBaseUrl, toBookID),
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true}`))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/addressbooks/%d/emails", apiBaseUrl, fromBookID),
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"error_code": 400, "message": "Invalid data"}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Books.MoveEmails(fromBookID, toBookID, []string{"alice@example.com"})
+	transferErr, ok := err.(*TransferError)
+	assert.True(t, ok)
+	assert.Equal(t, 0, len(transferErr.Failed))
+	assert.Equal(t, []string{"alice@example.com"}, transferErr.NotRemoved)
+}
+
+func TestBooks_CopyEmails(t *testing.T) {
+	fromBookID, toBookID := 1, 2
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/emails/alice@example.com", apiBaseUrl, fromBookID),
+		httpmock.NewStringResponder(http.StatusOK, `{"email": "alice@example.com", "status": 1}`))
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/addressbooks/%d/emails", apiBaseUrl, toBookID),
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Books.CopyEmails(fromBookID, toBookID, []string{"alice@example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, httpmock.GetCallCountInfo()["DELETE "+fmt.Sprintf("%s/addressbooks/%d/emails", apiBaseUrl, fromBookID)])
+}
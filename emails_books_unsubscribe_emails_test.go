@@ -0,0 +1,75 @@
+package sendpulse
+
+import (
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestBooks_UnsubscribeEmails_Success(t *testing.T) {
+	var bookId int = 1
+	url := fmt.Sprintf("%s/addressbooks/%d/emails/unsubscribe", apiBaseUrl, bookId)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", url,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Books.UnsubscribeEmails(bookId, []string{"alice@example.com", "bob@example.com"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"emails": ["alice@example.com", "bob@example.com"]}`, requestBody)
+}
+
+func TestBooks_UnsubscribeEmails_Empty(t *testing.T) {
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+
+	err := spClient.Emails.Books.UnsubscribeEmails(1, nil)
+	assert.Equal(t, ErrEmptyEmails, err)
+}
+
+func TestBooks_UnsubscribeEmails_InvalidResponse(t *testing.T) {
+	var bookId int = 1
+	url := fmt.Sprintf("%s/addressbooks/%d/emails/unsubscribe", apiBaseUrl, bookId)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", url,
+		httpmock.NewStringResponder(http.StatusOK, `{"result": false}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Books.UnsubscribeEmails(bookId, []string{fake.EmailAddress()})
+	assert.Error(t, err)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
@@ -0,0 +1,46 @@
+package sendpulse
+
+import (
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestBooks_UpdateEmailsStatus_Unsubscribed(t *testing.T) {
+	bookId := 1
+	url := fmt.Sprintf("%s/addressbooks/%d/emails/unsubscribe", apiBaseUrl, bookId)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", url,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Books.UpdateEmailsStatus(bookId, []string{"alice@example.com", "bob@example.com"}, ContactStatusUnsubscribed)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"emails": ["alice@example.com", "bob@example.com"]}`, requestBody)
+}
+
+func TestBooks_UpdateEmailsStatus_Unsupported(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Books.UpdateEmailsStatus(1, []string{"alice@example.com"}, ContactStatusActive)
+	assert.Equal(t, ErrUnsupportedStatus, err)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
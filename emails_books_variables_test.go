@@ -114,3 +114,40 @@ func TestBooks_Variables_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 4, len(variables))
 }
+
+func TestBooks_Variables_Types(t *testing.T) {
+	bookID := 1
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/variables", apiBaseUrl, bookID),
+		httpmock.NewStringResponder(http.StatusOK, `[{"name": "name", "type": "string"}, {"name": "birthday", "type": "date"}]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	variables, err := spClient.Emails.Books.Variables(bookID)
+	assert.NoError(t, err)
+	assert.Equal(t, []Variable{
+		{Name: "name", Type: VariableTypeString},
+		{Name: "birthday", Type: VariableTypeDate},
+	}, variables)
+}
+
+func TestBooks_Variables_Empty(t *testing.T) {
+	bookID := 1
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/addressbooks/%d/variables", apiBaseUrl, bookID),
+		httpmock.NewStringResponder(http.StatusOK, `{}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	variables, err := spClient.Emails.Books.Variables(bookID)
+	assert.NoError(t, err)
+	assert.Equal(t, []Variable{}, variables)
+}
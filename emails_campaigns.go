@@ -1,11 +1,10 @@
 package sendpulse
 
 import (
+	"context"
 	b64 "encoding/base64"
 	"encoding/json"
 	"fmt"
-	"net/http"
-	"strconv"
 	"time"
 )
 
@@ -60,70 +59,177 @@ type UpdateCampaignData struct {
 	SendDate    time.Time
 }
 
+// CampaignStatus is the state of a campaign as reported by SendPulse
+type CampaignStatus int
+
+const (
+	CampaignStatusNew        CampaignStatus = 0
+	CampaignStatusScheduled  CampaignStatus = 1
+	CampaignStatusSending    CampaignStatus = 2
+	CampaignStatusSent       CampaignStatus = 3
+	CampaignStatusCanceled   CampaignStatus = 4
+	CampaignStatusDraft      CampaignStatus = 5
+	CampaignStatusModeration CampaignStatus = 13
+)
+
+func (s CampaignStatus) String() string {
+	switch s {
+	case CampaignStatusNew:
+		return "new"
+	case CampaignStatusScheduled:
+		return "scheduled"
+	case CampaignStatusSending:
+		return "sending"
+	case CampaignStatusSent:
+		return "sent"
+	case CampaignStatusCanceled:
+		return "canceled"
+	case CampaignStatusDraft:
+		return "draft"
+	case CampaignStatusModeration:
+		return "moderation"
+	}
+	return fmt.Sprintf("unknown (%d)", int(s))
+}
+
 type CampaignStatisticsCounts struct {
-	Code    int
-	Count   int
-	Explain string
+	Code    int    `json:"code"`
+	Count   int    `json:"count"`
+	Explain string `json:"explain"`
 }
 
 type MessageInfo struct {
-	SenderName  string
-	SenderEmail string
-	Subject     string
-	Body        string
-	Attachments string
-	ListID      int
+	SenderName  string `json:"sender_name"`
+	SenderEmail string `json:"sender_email"`
+	Subject     string `json:"subject"`
+	Body        string `json:"body"`
+	Attachments string `json:"attachments"`
+	ListID      int    `json:"list_id"`
+	TemplateID  int    `json:"template_id"`
 }
 
 type CampaignInfo struct {
-	ID                int
-	Name              string
-	Message           MessageInfo
-	Status            int
-	AllEmailQty       int
-	TariffEmailQty    int
-	PaidEmailQty      int
-	OverdraftPrice    int
-	OverdraftCurrency string
+	ID                int            `json:"id"`
+	Name              string         `json:"name"`
+	Message           MessageInfo    `json:"message"`
+	Status            CampaignStatus `json:"status"`
+	AllEmailQty       int            `json:"all_email_qty"`
+	TariffEmailQty    int            `json:"tariff_email_qty"`
+	PaidEmailQty      int            `json:"paid_email_qty"`
+	OverdraftPrice    int            `json:"overdraft_price"`
+	OverdraftCurrency string         `json:"overdraft_currency"`
 }
 
+// CampaignFullInfo is a campaign with its delivery statistics (sent, opened, redirected by link, etc.)
 type CampaignFullInfo struct {
 	CampaignInfo
-	Statistics []CampaignStatisticsCounts
-	SendDate   time.Time
-	Permalink  string
+	Statistics []CampaignStatisticsCounts `json:"statistics"`
+	SendDate   time.Time                  `json:"send_date"`
+	Permalink  string                     `json:"permalink"`
 }
 
 type messageInfoRaw struct {
-	SenderName  string
-	SenderEmail string
-	Subject     string
-	Body        string
-	Attachments string
-	ListID      interface{}
+	SenderName  string      `json:"sender_name"`
+	SenderEmail string      `json:"sender_email"`
+	Subject     string      `json:"subject"`
+	Body        string      `json:"body"`
+	Attachments string      `json:"attachments"`
+	ListID      interface{} `json:"list_id"`
+	TemplateID  interface{} `json:"template_id"`
 }
 
 type campaignInfoRaw struct {
-	ID                interface{}
-	Name              string
-	Message           messageInfoRaw
-	Status            interface{}
-	AllEmailQty       interface{}
-	TariffEmailQty    interface{}
-	PaidEmailQty      interface{}
-	OverdraftPrice    interface{}
-	OverdraftCurrency string
+	ID                interface{}    `json:"id"`
+	Name              string         `json:"name"`
+	Message           messageInfoRaw `json:"message"`
+	Status            interface{}    `json:"status"`
+	AllEmailQty       interface{}    `json:"all_email_qty"`
+	TariffEmailQty    interface{}    `json:"tariff_email_qty"`
+	PaidEmailQty      interface{}    `json:"paid_email_qty"`
+	OverdraftPrice    interface{}    `json:"overdraft_price"`
+	OverdraftCurrency string         `json:"overdraft_currency"`
+}
+
+type campaignStatisticsCountsRaw struct {
+	Code    interface{} `json:"code"`
+	Count   interface{} `json:"count"`
+	Explain string      `json:"explain"`
+}
+
+type campaignFullInfoRaw struct {
+	campaignInfoRaw
+	Statistics []campaignStatisticsCountsRaw `json:"statistics"`
+	SendDate   string                        `json:"send_date"`
+	Permalink  string                        `json:"permalink"`
+}
+
+func (raw campaignInfoRaw) campaignInfo() CampaignInfo {
+	return CampaignInfo{
+		ID:   toInt(raw.ID),
+		Name: raw.Name,
+		Message: MessageInfo{
+			SenderName:  raw.Message.SenderName,
+			SenderEmail: raw.Message.SenderEmail,
+			Subject:     raw.Message.Subject,
+			Body:        raw.Message.Body,
+			Attachments: raw.Message.Attachments,
+			ListID:      toInt(raw.Message.ListID),
+			TemplateID:  toInt(raw.Message.TemplateID),
+		},
+		Status:            CampaignStatus(toInt(raw.Status)),
+		AllEmailQty:       toInt(raw.AllEmailQty),
+		TariffEmailQty:    toInt(raw.TariffEmailQty),
+		PaidEmailQty:      toInt(raw.PaidEmailQty),
+		OverdraftPrice:    toInt(raw.OverdraftPrice),
+		OverdraftCurrency: raw.OverdraftCurrency,
+	}
 }
 
 type ReferralsStatistics struct {
-	Link  string
-	Count int
+	Link  string `json:"link"`
+	Count int    `json:"count"`
+}
+
+type referralsStatisticsRaw struct {
+	Link  string      `json:"link"`
+	Count interface{} `json:"count"`
 }
 
+// Create creates a campaign. It's sent immediately unless SendDate is set, in which case it's scheduled for that moment.
+// With IsDraft it's only saved, with CampaignStatusDraft, until released with SendDraft.
+// Without SenderEmail the campaign is sent from Senders.Default, which is requested for every such campaign.
 // Limit: 4 mailing per hour
 func (c *campaigns) Create(campaignData CreateCampaignData) (*CreatedCampaignData, error) {
+	return c.CreateContext(context.Background(), campaignData)
+}
+
+func (c *campaigns) CreateContext(ctx context.Context, campaignData CreateCampaignData) (*CreatedCampaignData, error) {
 	path := "/campaigns"
 
+	campaignData, err := c.withDefaultSender(ctx, campaignData)
+	if err != nil {
+		return nil, err
+	}
+
+	data := campaignData.params()
+
+	method := "POST"
+	if len(campaignData.SendTestOnly) != 0 {
+		method = "PATCH"
+		encoded, _ := json.Marshal(campaignData.SendTestOnly)
+		data["send_test_only"] = encoded
+	}
+
+	body, err := c.Client.makeRequest(ctx, path, method, data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.decodeCreatedCampaign(method, path, body)
+}
+
+// params returns the request parameters of the campaign, except send_test_only
+func (campaignData CreateCampaignData) params() map[string]interface{} {
 	data := map[string]interface{}{
 		"sender_name":  campaignData.SenderName,
 		"sender_email": campaignData.SenderEmail,
@@ -144,50 +250,42 @@ func (c *campaigns) Create(campaignData CreateCampaignData) (*CreatedCampaignDat
 	}
 
 	if !campaignData.SendDate.IsZero() {
-		data["send_date"] = campaignData.SendDate.Format("2006-01-02 15:04:05")
+		data["send_date"] = formatSendDate(campaignData.SendDate)
 	}
 
 	if campaignData.Name != "" {
 		data["name"] = campaignData.Name
 	}
 
-	method := "POST"
-	if len(campaignData.SendTestOnly) != 0 {
-		method = "PATCH"
-		encoded, _ := json.Marshal(campaignData.SendTestOnly)
-		data["send_test_only"] = encoded
-	}
-
-	body, err := c.Client.makeRequest(fmt.Sprintf(path), method, data, true)
-	if err != nil {
-		return nil, err
-	}
+	return data
+}
 
+func (c *campaigns) decodeCreatedCampaign(method string, path string, body []byte) (*CreatedCampaignData, error) {
 	var raw createdCampaignDataRaw
 	if err := json.Unmarshal(body, &raw); err != nil {
-		return nil, &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+		return nil, c.Client.invalidResponse(method, path, body, err.Error())
 	}
 
-	id, _ := strconv.Atoi(fmt.Sprint(raw.ID))
-	status, _ := strconv.Atoi(fmt.Sprint(raw.Status))
-	count, _ := strconv.Atoi(fmt.Sprint(raw.Count))
-	tariffEmailQty, _ := strconv.Atoi(fmt.Sprint(raw.TariffEmailQty))
-	paidEmailQty, _ := strconv.Atoi(fmt.Sprint(raw.PaidEmailQty))
-	overdraftPrice, _ := strconv.Atoi(fmt.Sprint(raw.OverdraftPrice))
-
 	createdCampaign := CreatedCampaignData{
-		ID:                id,
-		Status:            status,
-		Count:             count,
-		TariffEmailQty:    tariffEmailQty,
-		PaidEmailQty:      paidEmailQty,
-		OverdraftPrice:    overdraftPrice,
+		ID:                toInt(raw.ID),
+		Status:            toInt(raw.Status),
+		Count:             toInt(raw.Count),
+		TariffEmailQty:    toInt(raw.TariffEmailQty),
+		PaidEmailQty:      toInt(raw.PaidEmailQty),
+		OverdraftPrice:    toInt(raw.OverdraftPrice),
 		OverdraftCurrency: raw.OverdraftCurrency,
 	}
-	return &createdCampaign, err
+	if createdCampaign.ID == 0 {
+		return nil, c.Client.invalidResponse(method, path, body, "'id' not found in response")
+	}
+	return &createdCampaign, nil
 }
 
 func (c *campaigns) Update(campaignData UpdateCampaignData) error {
+	return c.UpdateContext(context.Background(), campaignData)
+}
+
+func (c *campaigns) UpdateContext(ctx context.Context, campaignData UpdateCampaignData) error {
 	path := "/campaigns"
 
 	data := map[string]interface{}{
@@ -198,49 +296,66 @@ func (c *campaigns) Update(campaignData UpdateCampaignData) error {
 		"subject":      campaignData.Subject,
 		"body":         b64.StdEncoding.EncodeToString([]byte(campaignData.Body)),
 		"template_od":  campaignData.TemplateID,
-		"send_date":    campaignData.SendDate.Format("2006-01-02 15:04:05"),
 	}
 
-	body, err := c.Client.makeRequest(fmt.Sprintf(path), "PATCH", data, true)
-	if err != nil {
-		return err
+	if !campaignData.SendDate.IsZero() {
+		data["send_date"] = formatSendDate(campaignData.SendDate)
 	}
 
-	var respData map[string]interface{}
-	if err := json.Unmarshal(body, &respData); err != nil {
-		return &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+	body, err := c.Client.makeRequest(ctx, path, "PATCH", data, true)
+	if err != nil {
+		return err
 	}
 
-	result, resultExists := respData["result"]
-	if !resultExists || !result.(bool) {
-		return &SendpulseError{http.StatusOK, path, string(body), "invalid response"}
-	}
-	return nil
+	return c.Client.checkResult("PATCH", path, body)
 }
 
 func (c *campaigns) Get(campaignID int) (*CampaignFullInfo, error) {
+	return c.GetContext(context.Background(), campaignID)
+}
+
+func (c *campaigns) GetContext(ctx context.Context, campaignID int) (*CampaignFullInfo, error) {
 	path := fmt.Sprintf("/campaigns/%d", campaignID)
-	body, err := c.Client.makeRequest(path, "GET", nil, true)
+	body, err := c.Client.makeRequest(ctx, path, "GET", nil, true)
 
 	if err != nil {
 		return nil, err
 	}
 
-	var fullInfo CampaignFullInfo
-	if err := json.Unmarshal(body, &fullInfo); err != nil {
-		return nil, &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+	var raw campaignFullInfoRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, c.Client.invalidResponse("GET", path, body, err.Error())
 	}
 
-	return &fullInfo, err
+	fullInfo := CampaignFullInfo{
+		CampaignInfo: raw.campaignInfo(),
+		Permalink:    raw.Permalink,
+	}
+	for _, stat := range raw.Statistics {
+		fullInfo.Statistics = append(fullInfo.Statistics, CampaignStatisticsCounts{
+			Code:    toInt(stat.Code),
+			Count:   toInt(stat.Count),
+			Explain: stat.Explain,
+		})
+	}
+	if sendDate, err := time.Parse(sendDateLayout, raw.SendDate); err == nil {
+		fullInfo.SendDate = sendDate
+	}
+
+	return &fullInfo, nil
 }
 
 func (c *campaigns) List(limit int, offset int) ([]CampaignInfo, error) {
+	return c.ListContext(context.Background(), limit, offset)
+}
+
+func (c *campaigns) ListContext(ctx context.Context, limit int, offset int) ([]CampaignInfo, error) {
 	path := "/campaigns"
 	data := map[string]interface{}{
 		"limit":  fmt.Sprint(limit),
 		"offset": fmt.Sprint(offset),
 	}
-	body, err := c.Client.makeRequest(path, "GET", data, true)
+	body, err := c.Client.makeRequest(ctx, path, "GET", data, true)
 
 	if err != nil {
 		return nil, err
@@ -248,87 +363,84 @@ func (c *campaigns) List(limit int, offset int) ([]CampaignInfo, error) {
 
 	var respData []campaignInfoRaw
 	if err := json.Unmarshal(body, &respData); err != nil {
-		return nil, &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+		return nil, c.Client.invalidResponse("GET", path, body, err.Error())
 	}
 
 	var campaignsList []CampaignInfo
 	for _, raw := range respData {
-		id, _ := strconv.Atoi(fmt.Sprint(raw.ID))
-		status, _ := strconv.Atoi(fmt.Sprint(raw.Status))
-		allEmailQty, _ := strconv.Atoi(fmt.Sprint(raw.AllEmailQty))
-		tariffEmailQty, _ := strconv.Atoi(fmt.Sprint(raw.TariffEmailQty))
-		paidEmailQty, _ := strconv.Atoi(fmt.Sprint(raw.PaidEmailQty))
-		overdraftPrice, _ := strconv.Atoi(fmt.Sprint(raw.OverdraftPrice))
-		listID, _ := strconv.Atoi(fmt.Sprint(raw.Message.ListID))
-
-		campaignsList = append(campaignsList, CampaignInfo{
-			ID:   id,
-			Name: raw.Name,
-			Message: MessageInfo{
-				SenderName:  raw.Message.SenderName,
-				SenderEmail: raw.Message.SenderEmail,
-				Subject:     raw.Message.Subject,
-				Body:        raw.Message.Body,
-				Attachments: raw.Message.Attachments,
-				ListID:      listID,
-			},
-			Status:            status,
-			AllEmailQty:       allEmailQty,
-			TariffEmailQty:    tariffEmailQty,
-			PaidEmailQty:      paidEmailQty,
-			OverdraftPrice:    overdraftPrice,
-			OverdraftCurrency: raw.OverdraftCurrency,
-		})
+		campaignsList = append(campaignsList, raw.campaignInfo())
 	}
 
 	return campaignsList, nil
 }
 
+// Countries returns the number of opens by country code. Countries without opens are omitted
 func (c *campaigns) Countries(campaignID int) (map[string]int, error) {
+	return c.CountriesContext(context.Background(), campaignID)
+}
+
+func (c *campaigns) CountriesContext(ctx context.Context, campaignID int) (map[string]int, error) {
 	path := fmt.Sprintf("/campaigns/%d/countries", campaignID)
 
-	body, err := c.Client.makeRequest(path, "GET", nil, true)
+	body, err := c.Client.makeRequest(ctx, path, "GET", nil, true)
 	if err != nil {
 		return nil, err
 	}
 
-	var respData map[string]int
-	if err := json.Unmarshal(body, &respData); err != nil {
-		return nil, &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+	respData := make(map[string]int)
+	if isEmptyCollection(body) {
+		return respData, nil
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, c.Client.invalidResponse("GET", path, body, err.Error())
 	}
 
+	for country, count := range raw {
+		respData[country] = toInt(count)
+	}
 	return respData, nil
 }
 
+// Referrals returns the number of redirects by every link of the campaign
 func (c *campaigns) Referrals(campaignID int) ([]ReferralsStatistics, error) {
+	return c.ReferralsContext(context.Background(), campaignID)
+}
+
+func (c *campaigns) ReferralsContext(ctx context.Context, campaignID int) ([]ReferralsStatistics, error) {
 	path := fmt.Sprintf("/campaigns/%d/referrals", campaignID)
 
-	body, err := c.Client.makeRequest(path, "GET", nil, true)
+	body, err := c.Client.makeRequest(ctx, path, "GET", nil, true)
 	if err != nil {
 		return nil, err
 	}
 
-	var respData []ReferralsStatistics
-	if err := json.Unmarshal(body, &respData); err != nil {
-		return nil, &SendpulseError{http.StatusOK, path, string(body), err.Error()}
+	respData := make([]ReferralsStatistics, 0)
+	if isEmptyCollection(body) {
+		return respData, nil
+	}
+
+	var raw []referralsStatisticsRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, c.Client.invalidResponse("GET", path, body, err.Error())
 	}
 
+	for _, stat := range raw {
+		respData = append(respData, ReferralsStatistics{Link: stat.Link, Count: toInt(stat.Count)})
+	}
 	return respData, nil
 }
 
 func (c *campaigns) Cancel(campaignID int) error {
+	return c.CancelContext(context.Background(), campaignID)
+}
+
+func (c *campaigns) CancelContext(ctx context.Context, campaignID int) error {
 	path := fmt.Sprintf("/campaigns/%d", campaignID)
-	body, err := c.Client.makeRequest(path, "DELETE", nil, true)
+	body, err := c.Client.makeRequest(ctx, path, "DELETE", nil, true)
 	if err != nil {
 		return err
 	}
-	var respData map[string]interface{}
-	if err := json.Unmarshal(body, &respData); err != nil {
-		return &SendpulseError{http.StatusOK, path, string(body), err.Error()}
-	}
-	result, resultExists := respData["result"]
-	if !resultExists || !result.(bool) {
-		return &SendpulseError{http.StatusOK, path, string(body), "invalid response"}
-	}
-	return nil
+	return c.Client.checkResult("DELETE", path, body)
 }
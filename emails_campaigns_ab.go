@@ -0,0 +1,176 @@
+package sendpulse
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrTooFewVariants is returned when an A/B test has less than two variants
+	ErrTooFewVariants = errors.New("a/b test needs at least two variants")
+	// ErrInvalidVariantWeights is returned when the variant weights don't sum to 100 or only some of them are set
+	ErrInvalidVariantWeights = errors.New("a/b test variant weights must sum to 100")
+	// ErrInvalidTestGroup is returned when the test group isn't between 1 and 100 percent of the list
+	ErrInvalidTestGroup = errors.New("a/b test group must be between 1 and 100 percent")
+	// ErrInvalidWinnerMetric is returned when the winner metric is neither ABWinnerByOpens nor ABWinnerByClicks
+	ErrInvalidWinnerMetric = errors.New("unknown a/b test winner metric")
+)
+
+// ABWinnerMetric is the statistic the winning variant of an A/B test is chosen by
+type ABWinnerMetric string
+
+const (
+	ABWinnerByOpens  ABWinnerMetric = "open"
+	ABWinnerByClicks ABWinnerMetric = "click"
+)
+
+// ABVariant is a variant of an A/B test campaign. Empty Subject or Body fall back to the ones of ABCampaignParams.
+// Weight is the share of the test group in percent; when all weights are zero the group is split equally
+type ABVariant struct {
+	Subject string
+	Body    string
+	Weight  int
+}
+
+// ABCampaignParams describes an A/B test campaign: the variants are sent to TestPercent of the list,
+// and after WinnerDelay the variant with the best WinnerMetric is sent to the rest of it
+type ABCampaignParams struct {
+	Name         string
+	SenderName   string
+	SenderEmail  string
+	Subject      string
+	Body         string
+	ListID       int
+	Variants     []ABVariant
+	TestPercent  int
+	WinnerMetric ABWinnerMetric
+	WinnerDelay  time.Duration
+}
+
+// ABCampaignResult is the created A/B test campaign with the ids of its variants in the order they were passed
+type ABCampaignResult struct {
+	ID         int
+	VariantIDs []int
+}
+
+type abCampaignResultRaw struct {
+	ID       interface{} `json:"id"`
+	Variants []struct {
+		ID interface{} `json:"id"`
+	} `json:"variants"`
+}
+
+func (p ABCampaignParams) validate() error {
+	if len(p.Variants) < 2 {
+		return ErrTooFewVariants
+	}
+
+	sum := 0
+	zeros := 0
+	for _, variant := range p.Variants {
+		if variant.Weight < 0 {
+			return ErrInvalidVariantWeights
+		}
+		if variant.Weight == 0 {
+			zeros++
+		}
+		sum += variant.Weight
+	}
+	if zeros != 0 && zeros != len(p.Variants) {
+		return ErrInvalidVariantWeights
+	}
+	if zeros == 0 && sum != 100 {
+		return ErrInvalidVariantWeights
+	}
+
+	if p.TestPercent < 1 || p.TestPercent > 100 {
+		return ErrInvalidTestGroup
+	}
+
+	if p.WinnerMetric != ABWinnerByOpens && p.WinnerMetric != ABWinnerByClicks {
+		return ErrInvalidWinnerMetric
+	}
+
+	return nil
+}
+
+// CreateABCampaign creates an A/B test campaign. The parameters are validated before anything is sent
+func (c *campaigns) CreateABCampaign(params ABCampaignParams) (*ABCampaignResult, error) {
+	return c.CreateABCampaignContext(context.Background(), params)
+}
+
+func (c *campaigns) CreateABCampaignContext(ctx context.Context, params ABCampaignParams) (*ABCampaignResult, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	path := "/campaigns/ab"
+
+	variants := make([]map[string]interface{}, 0, len(params.Variants))
+	for i, variant := range params.Variants {
+		subject := variant.Subject
+		if subject == "" {
+			subject = params.Subject
+		}
+		body := variant.Body
+		if body == "" {
+			body = params.Body
+		}
+		weight := variant.Weight
+		if weight == 0 {
+			// validate() lets zero weights through only when all of them are zero: the first variant
+			// gets the remainder of the equal split, so the weights still sum to 100
+			weight = 100 / len(params.Variants)
+			if i == 0 {
+				weight += 100 % len(params.Variants)
+			}
+		}
+		variants = append(variants, map[string]interface{}{
+			"subject": subject,
+			"body":    b64.StdEncoding.EncodeToString([]byte(body)),
+			"weight":  weight,
+		})
+	}
+
+	payload := map[string]interface{}{
+		"sender_name":     params.SenderName,
+		"sender_email":    params.SenderEmail,
+		"list_id":         params.ListID,
+		"test_percent":    params.TestPercent,
+		"winner_criteria": string(params.WinnerMetric),
+		"winner_delay":    int(params.WinnerDelay / time.Minute),
+		"variants":        variants,
+	}
+	if params.Name != "" {
+		payload["name"] = params.Name
+	}
+
+	body, err := c.Client.makeJSONRequest(ctx, path, "POST", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Client.checkResult("POST", path, body); err != nil {
+		return nil, err
+	}
+
+	var raw abCampaignResultRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, c.Client.invalidResponse("POST", path, body, err.Error())
+	}
+
+	result := ABCampaignResult{
+		ID:         toInt(raw.ID),
+		VariantIDs: make([]int, 0, len(raw.Variants)),
+	}
+	if result.ID == 0 {
+		return nil, c.Client.invalidResponse("POST", path, body, "'id' not found in response")
+	}
+	for _, variant := range raw.Variants {
+		result.VariantIDs = append(result.VariantIDs, toInt(variant.ID))
+	}
+	return &result, nil
+}
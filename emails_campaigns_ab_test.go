@@ -0,0 +1,170 @@
+package sendpulse
+
+import (
+	b64 "encoding/base64"
+	"encoding/json"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func abCampaignParams() ABCampaignParams {
+	return ABCampaignParams{
+		Name:        "Subject test",
+		SenderName:  "Shop",
+		SenderEmail: "news@example.com",
+		Body:        "<p>Hello</p>",
+		ListID:      2128929,
+		Variants: []ABVariant{
+			{Subject: "Autumn sale", Weight: 50},
+			{Subject: "Up to 50% off", Weight: 50},
+		},
+		TestPercent:  20,
+		WinnerMetric: ABWinnerByOpens,
+		WinnerDelay:  4 * time.Hour,
+	}
+}
+
+func TestCampaigns_CreateABCampaign(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var payload map[string]interface{}
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/campaigns/ab",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &payload)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "id": 10113871, "variants": [{"id": 1}, {"id": "2"}]}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.Emails.Campaigns.CreateABCampaign(abCampaignParams())
+	assert.NoError(t, err)
+	assert.Equal(t, ABCampaignResult{ID: 10113871, VariantIDs: []int{1, 2}}, *result)
+
+	encodedBody := b64.StdEncoding.EncodeToString([]byte("<p>Hello</p>"))
+	assert.Equal(t, map[string]interface{}{
+		"name":            "Subject test",
+		"sender_name":     "Shop",
+		"sender_email":    "news@example.com",
+		"list_id":         float64(2128929),
+		"test_percent":    float64(20),
+		"winner_criteria": "open",
+		"winner_delay":    float64(240),
+		"variants": []interface{}{
+			map[string]interface{}{"subject": "Autumn sale", "body": encodedBody, "weight": float64(50)},
+			map[string]interface{}{"subject": "Up to 50% off", "body": encodedBody, "weight": float64(50)},
+		},
+	}, payload)
+}
+
+func TestCampaigns_CreateABCampaign_Validation(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	oneVariant := abCampaignParams()
+	oneVariant.Variants = oneVariant.Variants[:1]
+	_, err := spClient.Emails.Campaigns.CreateABCampaign(oneVariant)
+	assert.Equal(t, ErrTooFewVariants, err)
+
+	badWeights := abCampaignParams()
+	badWeights.Variants[1].Weight = 30
+	_, err = spClient.Emails.Campaigns.CreateABCampaign(badWeights)
+	assert.Equal(t, ErrInvalidVariantWeights, err)
+
+	someZero := abCampaignParams()
+	someZero.Variants[0].Weight = 100
+	someZero.Variants[1].Weight = 0
+	_, err = spClient.Emails.Campaigns.CreateABCampaign(someZero)
+	assert.Equal(t, ErrInvalidVariantWeights, err)
+
+	badGroup := abCampaignParams()
+	badGroup.TestPercent = 0
+	_, err = spClient.Emails.Campaigns.CreateABCampaign(badGroup)
+	assert.Equal(t, ErrInvalidTestGroup, err)
+
+	badMetric := abCampaignParams()
+	badMetric.WinnerMetric = "reply"
+	_, err = spClient.Emails.Campaigns.CreateABCampaign(badMetric)
+	assert.Equal(t, ErrInvalidWinnerMetric, err)
+
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestCampaigns_CreateABCampaign_EqualSplit(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var payload struct {
+		Variants []struct {
+			Weight int `json:"weight"`
+		} `json:"variants"`
+	}
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/campaigns/ab",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &payload)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "id": 10113871, "variants": [{"id": 1}, {"id": 2}, {"id": 3}]}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	params := abCampaignParams()
+	params.Variants = []ABVariant{{Subject: "A"}, {Subject: "B"}, {Subject: "C"}}
+	_, err := spClient.Emails.Campaigns.CreateABCampaign(params)
+	assert.NoError(t, err)
+
+	weights := make([]int, 0, len(payload.Variants))
+	for _, variant := range payload.Variants {
+		weights = append(weights, variant.Weight)
+	}
+	assert.Equal(t, []int{34, 33, 33}, weights)
+}
+
+func TestCampaigns_CreateABCampaign_NoID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	respBody := `{"result": true, "variants": [{"id": 1}, {"id": 2}]}`
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/campaigns/ab",
+		httpmock.NewStringResponder(http.StatusOK, respBody))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.Emails.Campaigns.CreateABCampaign(abCampaignParams())
+	assert.Nil(t, result)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, respBody, spErr.Body)
+	assert.Equal(t, "'id' not found in response", spErr.Message)
+}
+
+func TestCampaigns_CreateABCampaign_BadJson(t *testing.T) {
+	path := "/campaigns/ab"
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+path,
+		httpmock.NewStringResponder(http.StatusOK, `Invalid json`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.Emails.Campaigns.CreateABCampaign(abCampaignParams())
+	assert.Nil(t, result)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, apiBaseUrl+path, spErr.Url)
+}
@@ -0,0 +1,66 @@
+package sendpulse
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrCampaignContentNotFound is returned by Campaigns.Content when the campaign doesn't exist (e.g. it was deleted)
+// or has neither a stored body nor a template
+var ErrCampaignContentNotFound = errors.New("campaign content not found")
+
+// Content returns the HTML of the campaign message. The body is base64-decoded; a campaign created from a template
+// without a stored body gets the HTML of the template as it is now, which may differ from the one sent
+func (c *campaigns) Content(campaignID int) (string, error) {
+	return c.ContentContext(context.Background(), campaignID)
+}
+
+func (c *campaigns) ContentContext(ctx context.Context, campaignID int) (string, error) {
+	path := fmt.Sprintf("/campaigns/%d", campaignID)
+	body, err := c.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		if spErr, ok := err.(*SendpulseError); ok && spErr.HttpCode == http.StatusNotFound {
+			return "", ErrCampaignContentNotFound
+		}
+		return "", err
+	}
+
+	var raw campaignFullInfoRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return "", c.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	return c.messageContent(ctx, raw.campaignInfo().Message)
+}
+
+// messageContent returns the decoded HTML of the campaign message, or the HTML of its template if it has no body
+func (c *campaigns) messageContent(ctx context.Context, message MessageInfo) (string, error) {
+	if message.Body != "" {
+		decoded, err := b64.StdEncoding.DecodeString(message.Body)
+		if err != nil {
+			// Older campaigns may store plain HTML
+			return message.Body, nil
+		}
+		return string(decoded), nil
+	}
+
+	if message.TemplateID == 0 {
+		return "", ErrCampaignContentNotFound
+	}
+	t := &templates{Client: c.Client}
+	tpl, err := t.GetContext(ctx, fmt.Sprint(message.TemplateID))
+	if err != nil {
+		if spErr, ok := err.(*SendpulseError); ok && spErr.HttpCode == http.StatusNotFound {
+			return "", ErrCampaignContentNotFound
+		}
+		return "", err
+	}
+	if tpl.Body == "" {
+		return "", ErrCampaignContentNotFound
+	}
+	return tpl.Body, nil
+}
@@ -0,0 +1,66 @@
+package sendpulse
+
+import (
+	b64 "encoding/base64"
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestCampaigns_Content(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	html := "<h1>Spring sale</h1><p>Up to 50% off</p>"
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/12",
+		httpmock.NewStringResponder(http.StatusOK, fmt.Sprintf(`{"id": 12, "message": {"subject": "Sale", "body": "%s"}}`,
+			b64.StdEncoding.EncodeToString([]byte(html)))))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	content, err := spClient.Emails.Campaigns.Content(12)
+	assert.NoError(t, err)
+	assert.Equal(t, html, content)
+}
+
+func TestCampaigns_Content_Template(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	html := "<p>Hello from the template</p>"
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/12",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 12, "message": {"subject": "Sale", "body": "", "template_id": 345}}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/template/345",
+		httpmock.NewStringResponder(http.StatusOK, fmt.Sprintf(`{"id": 345, "name": "Promo", "body": "%s"}`,
+			b64.StdEncoding.EncodeToString([]byte(html)))))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	content, err := spClient.Emails.Campaigns.Content(12)
+	assert.NoError(t, err)
+	assert.Equal(t, html, content)
+}
+
+func TestCampaigns_Content_NotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/12",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"error_code": 404, "message": "Not found"}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/13",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 13, "message": {"subject": "Sale", "body": ""}}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Emails.Campaigns.Content(12)
+	assert.Equal(t, ErrCampaignContentNotFound, err)
+
+	_, err = spClient.Emails.Campaigns.Content(13)
+	assert.Equal(t, ErrCampaignContentNotFound, err)
+}
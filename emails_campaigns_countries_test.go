@@ -92,3 +92,25 @@ func TestCampaigns_Countries_Error(t *testing.T) {
 	_, isResponseError := err.(*SendpulseError)
 	assert.True(t, isResponseError)
 }
+
+func TestCampaigns_Countries_Empty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	for _, respBody := range []string{`{}`, `[]`} {
+		httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/1/countries",
+			httpmock.NewStringResponder(http.StatusOK, respBody))
+
+		stat, err := spClient.Emails.Campaigns.Countries(1)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{}, stat)
+	}
+}
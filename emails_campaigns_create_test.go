@@ -1,11 +1,13 @@
 package sendpulse
 
 import (
+	b64 "encoding/base64"
 	"encoding/json"
 	"github.com/icrowley/fake"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/jarcoal/httpmock.v1"
 	"net/http"
+	"net/url"
 	"testing"
 	"time"
 )
@@ -55,7 +57,7 @@ func TestCampaigns_Create_IncorrectJson(t *testing.T) {
 	assert.Nil(t, createdCampaignData)
 
 	assert.Equal(t, http.StatusOK, spErr.HttpCode)
-	assert.Equal(t, path, spErr.Url)
+	assert.Equal(t, apiBaseUrl+path, spErr.Url)
 	assert.Equal(t, respBody, spErr.Body)
 }
 
@@ -104,7 +106,7 @@ func TestCampaigns_Create_Error(t *testing.T) {
 	assert.Nil(t, createdCampaignData)
 
 	assert.Equal(t, http.StatusBadRequest, spErr.HttpCode)
-	assert.Equal(t, path, spErr.Url)
+	assert.Equal(t, apiBaseUrl+path, spErr.Url)
 	assert.Equal(t, respBody, spErr.Body)
 }
 
@@ -200,3 +202,139 @@ func TestCampaigns_Create_Success_WithTestEmails(t *testing.T) {
 	_, err := spClient.Emails.Campaigns.Create(data)
 	assert.NoError(t, err)
 }
+
+func TestCampaigns_Create_SendDate(t *testing.T) {
+	location := time.FixedZone("UTC+3", 3*60*60)
+	data := CreateCampaignData{
+		SenderName:  fake.Word(),
+		SenderEmail: fake.EmailAddress(),
+		Subject:     fake.Word(),
+		Body:        "<h1>Hello</h1>",
+		ListID:      1,
+		SendDate:    time.Date(2030, 1, 2, 10, 30, 0, 0, location),
+	}
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var form url.Values
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/campaigns",
+		func(req *http.Request) (*http.Response, error) {
+			req.ParseForm()
+			form = req.PostForm
+			return httpmock.NewStringResponse(http.StatusOK, `{"id":27,"status":13,"count":1,"tariff_email_qty":1}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	created, err := spClient.Emails.Campaigns.Create(data)
+	assert.NoError(t, err)
+	assert.Equal(t, 27, created.ID)
+	assert.Equal(t, 13, created.Status)
+	assert.Equal(t, 1, created.Count)
+	assert.Equal(t, 1, created.TariffEmailQty)
+
+	assert.Equal(t, "2030-01-02 07:30:00", form.Get("send_date"))
+	assert.Equal(t, b64.StdEncoding.EncodeToString([]byte("<h1>Hello</h1>")), form.Get("body"))
+}
+
+func TestCampaigns_Create_Immediate(t *testing.T) {
+	data := CreateCampaignData{
+		SenderName:  fake.Word(),
+		SenderEmail: fake.EmailAddress(),
+		Subject:     fake.Word(),
+		Body:        fake.Word(),
+		ListID:      1,
+	}
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var form url.Values
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/campaigns",
+		func(req *http.Request) (*http.Response, error) {
+			req.ParseForm()
+			form = req.PostForm
+			return httpmock.NewStringResponse(http.StatusOK, `{"id":27,"status":0,"count":1,"tariff_email_qty":1}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Emails.Campaigns.Create(data)
+	assert.NoError(t, err)
+	_, hasSendDate := form["send_date"]
+	assert.False(t, hasSendDate)
+}
+
+func TestCampaigns_Create_LargeID(t *testing.T) {
+	data := CreateCampaignData{
+		SenderName:  fake.Word(),
+		SenderEmail: fake.EmailAddress(),
+		Subject:     fake.Word(),
+		Body:        "<h1>Hello</h1>",
+		ListID:      1,
+	}
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/campaigns",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 12345678, "status": "13", "count": 1}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	created, err := spClient.Emails.Campaigns.Create(data)
+	assert.NoError(t, err)
+	assert.Equal(t, 12345678, created.ID)
+	assert.Equal(t, 13, created.Status)
+}
+
+func TestCampaigns_Create_NoID(t *testing.T) {
+	data := CreateCampaignData{
+		SenderName:  fake.Word(),
+		SenderEmail: fake.EmailAddress(),
+		Subject:     fake.Word(),
+		Body:        "<h1>Hello</h1>",
+		ListID:      1,
+	}
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	respBody := `{"status": 13, "count": 1}`
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/campaigns",
+		httpmock.NewStringResponder(http.StatusOK, respBody))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	created, err := spClient.Emails.Campaigns.Create(data)
+	assert.Nil(t, created)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, respBody, spErr.Body)
+	assert.Equal(t, "'id' not found in response", spErr.Message)
+}
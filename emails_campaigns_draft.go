@@ -0,0 +1,33 @@
+package sendpulse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrCampaignNotDraft is returned by SendDraft when the campaign isn't a draft, e.g. it was sent already
+var ErrCampaignNotDraft = errors.New("campaign is not a draft")
+
+// SendDraft sends a campaign created with IsDraft. The campaign is checked to be a draft first,
+// so a campaign that was already released isn't sent twice
+func (c *campaigns) SendDraft(campaignID int) error {
+	return c.SendDraftContext(context.Background(), campaignID)
+}
+
+func (c *campaigns) SendDraftContext(ctx context.Context, campaignID int) error {
+	campaign, err := c.GetContext(ctx, campaignID)
+	if err != nil {
+		return err
+	}
+	if campaign.Status != CampaignStatusDraft {
+		return ErrCampaignNotDraft
+	}
+
+	path := fmt.Sprintf("/campaigns/%d/send", campaignID)
+	body, err := c.Client.makeRequest(ctx, path, "POST", nil, true)
+	if err != nil {
+		return err
+	}
+	return c.Client.checkResult("POST", path, body)
+}
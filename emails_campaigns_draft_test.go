@@ -0,0 +1,60 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestCampaigns_SendDraft(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var form url.Values
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/campaigns",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			form, _ = url.ParseQuery(string(body))
+			return httpmock.NewStringResponse(http.StatusOK, `{"id": 77, "status": 5, "count": 120}`), nil
+		})
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/77",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 77, "name": "Spring sale", "status": 5}`))
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/campaigns/77/send",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	created, err := spClient.Emails.Campaigns.Create(CreateCampaignData{
+		SenderName:  "Shop",
+		SenderEmail: "shop@example.com",
+		Subject:     "Spring sale",
+		Body:        "<p>Sale</p>",
+		ListID:      1,
+		IsDraft:     true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "draft", form.Get("type"))
+	assert.Equal(t, CampaignStatusDraft, CampaignStatus(created.Status))
+
+	assert.NoError(t, spClient.Emails.Campaigns.SendDraft(created.ID))
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["POST "+apiBaseUrl+"/campaigns/77/send"])
+}
+
+func TestCampaigns_SendDraft_NotDraft(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/77",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 77, "name": "Spring sale", "status": 3}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	assert.Equal(t, ErrCampaignNotDraft, spClient.Emails.Campaigns.SendDraft(77))
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
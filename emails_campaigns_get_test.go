@@ -8,6 +8,7 @@ import (
 	"gopkg.in/jarcoal/httpmock.v1"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestCampaigns_Get_Success(t *testing.T) {
@@ -119,3 +120,77 @@ func TestCampaigns_Get_Error(t *testing.T) {
 	_, isResponseError := err.(*SendpulseError)
 	assert.True(t, isResponseError)
 }
+
+func TestCampaigns_Get_ApiResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/10113867",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"id": 10113867,
+			"name": "Spring sale",
+			"message": {"sender_name": "Shop", "sender_email": "shop@example.com", "subject": "Sale", "body": "", "attachments": "", "list_id": "2128929"},
+			"status": "3",
+			"all_email_qty": 3,
+			"tariff_email_qty": 3,
+			"paid_email_qty": 0,
+			"overdraft_price": 0,
+			"overdraft_currency": "USD",
+			"statistics": [
+				{"code": 1, "count": 3, "explain": "Sent"},
+				{"code": 3, "count": "2", "explain": "Opened"},
+				{"code": 4, "count": 1, "explain": "Link redirected"}
+			],
+			"send_date": "2030-01-02 07:30:00",
+			"permalink": "https://sendpulse.com/campaign/10113867"
+		}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	campaign, err := spClient.Emails.Campaigns.Get(10113867)
+	assert.NoError(t, err)
+	assert.Equal(t, 10113867, campaign.ID)
+	assert.Equal(t, 2128929, campaign.Message.ListID)
+	assert.Equal(t, CampaignStatusSent, campaign.Status)
+	assert.Equal(t, "sent", campaign.Status.String())
+	assert.Equal(t, []CampaignStatisticsCounts{
+		{Code: 1, Count: 3, Explain: "Sent"},
+		{Code: 3, Count: 2, Explain: "Opened"},
+		{Code: 4, Count: 1, Explain: "Link redirected"},
+	}, campaign.Statistics)
+	assert.Equal(t, time.Date(2030, 1, 2, 7, 30, 0, 0, time.UTC), campaign.SendDate)
+}
+
+func TestCampaigns_Get_NotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/1",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"error_code": 404, "message": "Campaign not found"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	campaign, err := spClient.Emails.Campaigns.Get(1)
+	assert.Nil(t, campaign)
+	spErr, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+	assert.Equal(t, http.StatusNotFound, spErr.HttpCode)
+}
+
+func TestCampaignStatus_String(t *testing.T) {
+	assert.Equal(t, "moderation", CampaignStatusModeration.String())
+	assert.Equal(t, "draft", CampaignStatusDraft.String())
+	assert.Equal(t, "unknown (42)", CampaignStatus(42).String())
+}
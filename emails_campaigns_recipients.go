@@ -0,0 +1,118 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Recipient statuses accepted by Campaigns.Recipients. An empty status means all the recipients
+const (
+	RecipientStatusSent         = "sent"
+	RecipientStatusOpened       = "opened"
+	RecipientStatusClicked      = "clicked"
+	RecipientStatusBounced      = "bounced"
+	RecipientStatusUnsubscribed = "unsubscribed"
+	RecipientStatusSpam         = "spam"
+)
+
+// RecipientStat is a recipient of a campaign with its last event. Date is when it happened
+type RecipientStat struct {
+	Email  string
+	Status string
+	Opened bool
+	Date   time.Time
+}
+
+type recipientStatRaw struct {
+	Email  string      `json:"email"`
+	Status string      `json:"status"`
+	Opened interface{} `json:"opened"`
+	Date   string      `json:"date"`
+}
+
+func (raw recipientStatRaw) recipientStat() RecipientStat {
+	stat := RecipientStat{
+		Email:  raw.Email,
+		Status: raw.Status,
+	}
+	// The flag may come as a boolean or a number
+	if opened, ok := raw.Opened.(bool); ok {
+		stat.Opened = opened
+	} else {
+		stat.Opened = toInt(raw.Opened) != 0
+	}
+	if date, err := time.Parse(sendDateLayout, raw.Date); err == nil {
+		stat.Date = date
+	}
+	return stat
+}
+
+// Recipients returns a page of the campaign recipients with the given status (see RecipientStatusOpened etc.).
+// Large campaigns have many recipients, so page through them with IterateRecipients rather than a big limit
+func (c *campaigns) Recipients(campaignID int, status string, limit int, offset int) ([]RecipientStat, error) {
+	return c.RecipientsContext(context.Background(), campaignID, status, limit, offset)
+}
+
+func (c *campaigns) RecipientsContext(ctx context.Context, campaignID int, status string, limit int, offset int) ([]RecipientStat, error) {
+	path := fmt.Sprintf("/campaigns/%d/recipients", campaignID)
+
+	data := map[string]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	}
+	if status != "" {
+		data["status"] = status
+	}
+
+	body, err := c.Client.makeRequest(ctx, path, "GET", data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make([]RecipientStat, 0)
+	if isEmptyCollection(body) {
+		return recipients, nil
+	}
+
+	var respData []recipientStatRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, c.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range respData {
+		recipients = append(recipients, raw.recipientStat())
+	}
+	return recipients, nil
+}
+
+// IterateRecipients calls fn for every campaign recipient with the given status requesting them by batchSize per call.
+// It stops when a page is shorter than batchSize or fn returns an error
+func (c *campaigns) IterateRecipients(campaignID int, status string, batchSize int, fn func(RecipientStat) error) error {
+	return c.IterateRecipientsContext(context.Background(), campaignID, status, batchSize, fn)
+}
+
+func (c *campaigns) IterateRecipientsContext(ctx context.Context, campaignID int, status string, batchSize int, fn func(RecipientStat) error) error {
+	if batchSize <= 0 {
+		return errors.New("batch size must be positive")
+	}
+
+	for offset := 0; ; offset += batchSize {
+		recipients, err := c.RecipientsContext(ctx, campaignID, status, batchSize, offset)
+		if err != nil {
+			return err
+		}
+
+		for _, recipient := range recipients {
+			if err := fn(recipient); err != nil {
+				return err
+			}
+		}
+
+		if len(recipients) < batchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package sendpulse
+
+import (
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCampaigns_Recipients_Opened(t *testing.T) {
+	campaignID := 10113867
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/campaigns/%d/recipients?limit=2&offset=0&status=opened", apiBaseUrl, campaignID),
+		httpmock.NewStringResponder(http.StatusOK, `[
+			{"email": "john@example.com", "status": "opened", "opened": 1, "date": "2026-10-01 09:15:00"},
+			{"email": "jane@example.com", "status": "opened", "opened": true, "date": "2026-10-02 18:40:12"}
+		]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	recipients, err := spClient.Emails.Campaigns.Recipients(campaignID, RecipientStatusOpened, 2, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []RecipientStat{
+		{Email: "john@example.com", Status: RecipientStatusOpened, Opened: true, Date: time.Date(2026, 10, 1, 9, 15, 0, 0, time.UTC)},
+		{Email: "jane@example.com", Status: RecipientStatusOpened, Opened: true, Date: time.Date(2026, 10, 2, 18, 40, 12, 0, time.UTC)},
+	}, recipients)
+}
+
+func TestCampaigns_IterateRecipients(t *testing.T) {
+	campaignID := 10113867
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/campaigns/%d/recipients?limit=2&offset=0&status=clicked", apiBaseUrl, campaignID),
+		httpmock.NewStringResponder(http.StatusOK, `[{"email": "a@example.com"}, {"email": "b@example.com"}]`))
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/campaigns/%d/recipients?limit=2&offset=2&status=clicked", apiBaseUrl, campaignID),
+		httpmock.NewStringResponder(http.StatusOK, `[{"email": "c@example.com"}]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	var emails []string
+	err := spClient.Emails.Campaigns.IterateRecipients(campaignID, RecipientStatusClicked, 2, func(recipient RecipientStat) error {
+		emails = append(emails, recipient.Email)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a@example.com", "b@example.com", "c@example.com"}, emails)
+}
+
+func TestCampaigns_Recipients_Empty(t *testing.T) {
+	campaignID := 10113867
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/campaigns/%d/recipients?limit=10&offset=0&status=bounced", apiBaseUrl, campaignID),
+		httpmock.NewStringResponder(http.StatusOK, `{}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	recipients, err := spClient.Emails.Campaigns.Recipients(campaignID, RecipientStatusBounced, 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []RecipientStat{}, recipients)
+}
@@ -99,3 +99,48 @@ func TestCampaigns_Referrals_Error(t *testing.T) {
 	_, isResponseError := err.(*SendpulseError)
 	assert.True(t, isResponseError)
 }
+
+func TestCampaigns_Referrals_ApiResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/1/referrals",
+		httpmock.NewStringResponder(http.StatusOK, `[{"link": "https://example.com/sale", "count": "12"}, {"link": "https://example.com", "count": 3}]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	stat, err := spClient.Emails.Campaigns.Referrals(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []ReferralsStatistics{
+		{Link: "https://example.com/sale", Count: 12},
+		{Link: "https://example.com", Count: 3},
+	}, stat)
+}
+
+func TestCampaigns_Referrals_Empty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	for _, respBody := range []string{`[]`, `{}`} {
+		httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/1/referrals",
+			httpmock.NewStringResponder(http.StatusOK, respBody))
+
+		stat, err := spClient.Emails.Campaigns.Referrals(1)
+		assert.NoError(t, err)
+		assert.Equal(t, []ReferralsStatistics{}, stat)
+	}
+}
@@ -0,0 +1,90 @@
+package sendpulse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNothingToResend is returned by ResendToUnopened when every recipient of the campaign opened it
+var ErrNothingToResend = errors.New("nothing to resend: every recipient opened the campaign")
+
+const (
+	campaignRecipientsPageSize = 100
+	// The non-openers are added to the new book by batches, as AddEmails accepts a limited number of contacts per request
+	resendBatchSize        = 100
+	resendBatchConcurrency = 2
+)
+
+// ResendToUnopened sends the campaign again to the recipients who didn't open it, with a new subject
+// (the original one is kept if newSubject is empty).
+// SendPulse has no resend endpoint, so the non-openers are read from the campaign recipients report and added
+// to a new address book named after the campaign, which the follow-up campaign is sent to. The book isn't deleted
+func (c *campaigns) ResendToUnopened(campaignID int, newSubject string) (*CreatedCampaignData, error) {
+	return c.ResendToUnopenedContext(context.Background(), campaignID, newSubject)
+}
+
+func (c *campaigns) ResendToUnopenedContext(ctx context.Context, campaignID int, newSubject string) (*CreatedCampaignData, error) {
+	campaign, err := c.GetContext(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients, err := c.unopenedRecipients(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if len(recipients) == 0 {
+		return nil, ErrNothingToResend
+	}
+
+	name := campaign.Name
+	if name == "" {
+		name = fmt.Sprintf("Campaign %d", campaignID)
+	}
+	name += " (not opened)"
+
+	content, err := c.messageContent(ctx, campaign.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &books{Client: c.Client}
+	bookID, err := b.CreateContext(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.AddEmailsBatchedContext(ctx, *bookID, recipients, resendBatchSize, resendBatchConcurrency); err != nil {
+		return nil, err
+	}
+
+	subject := newSubject
+	if subject == "" {
+		subject = campaign.Message.Subject
+	}
+
+	return c.CreateContext(ctx, CreateCampaignData{
+		SenderName:  campaign.Message.SenderName,
+		SenderEmail: campaign.Message.SenderEmail,
+		Subject:     subject,
+		Body:        content,
+		TemplateID:  campaign.Message.TemplateID,
+		ListID:      *bookID,
+		Name:        name,
+	})
+}
+
+// unopenedRecipients returns the recipients of the campaign who haven't opened it
+func (c *campaigns) unopenedRecipients(ctx context.Context, campaignID int) ([]Email, error) {
+	recipients := make([]Email, 0)
+	err := c.IterateRecipientsContext(ctx, campaignID, "", campaignRecipientsPageSize, func(recipient RecipientStat) error {
+		if recipient.Email != "" && !recipient.Opened {
+			recipients = append(recipients, Email{Email: recipient.Email})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recipients, nil
+}
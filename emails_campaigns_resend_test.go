@@ -0,0 +1,207 @@
+package sendpulse
+
+import (
+	b64 "encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestCampaigns_ResendToUnopened(t *testing.T) {
+	campaignID := 10113867
+	bookID := 2128930
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/campaigns/%d", apiBaseUrl, campaignID),
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"id": 10113867,
+			"name": "October news",
+			"message": {
+				"sender_name": "Shop",
+				"sender_email": "news@example.com",
+				"subject": "Our news",
+				"body": "PHA+SGVsbG88L3A+",
+				"list_id": 2128929
+			},
+			"status": 3
+		}`))
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/campaigns/%d/recipients?limit=100&offset=0", apiBaseUrl, campaignID),
+		httpmock.NewStringResponder(http.StatusOK, `[
+			{"email": "john@example.com", "opened": 1},
+			{"email": "jane@example.com", "opened": 0},
+			{"email": "bob@example.com", "opened": false}
+		]`))
+
+	var bookName string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/addressbooks",
+		func(req *http.Request) (*http.Response, error) {
+			_ = req.ParseForm()
+			bookName = req.PostForm.Get("bookName")
+			return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{"id": %d}`, bookID)), nil
+		})
+
+	var addedEmails []Email
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/addressbooks/%d/emails", apiBaseUrl, bookID),
+		func(req *http.Request) (*http.Response, error) {
+			var payload struct {
+				Emails []Email `json:"emails"`
+			}
+			body, _ := ioutil.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &payload)
+			addedEmails = payload.Emails
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	var campaignForm map[string]string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/campaigns",
+		func(req *http.Request) (*http.Response, error) {
+			_ = req.ParseForm()
+			campaignForm = map[string]string{
+				"subject":      req.PostForm.Get("subject"),
+				"list_id":      req.PostForm.Get("list_id"),
+				"sender_email": req.PostForm.Get("sender_email"),
+				"body":         req.PostForm.Get("body"),
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"id": 10113870, "status": 13, "count": 2}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.Emails.Campaigns.ResendToUnopened(campaignID, "Did you miss it?")
+	assert.NoError(t, err)
+	assert.Equal(t, 10113870, result.ID)
+	assert.Equal(t, 2, result.Count)
+
+	assert.Equal(t, "October news (not opened)", bookName)
+	assert.Equal(t, []Email{{Email: "jane@example.com"}, {Email: "bob@example.com"}}, addedEmails)
+	assert.Equal(t, "Did you miss it?", campaignForm["subject"])
+	assert.Equal(t, fmt.Sprint(bookID), campaignForm["list_id"])
+	assert.Equal(t, "news@example.com", campaignForm["sender_email"])
+	decodedBody, err := b64.StdEncoding.DecodeString(campaignForm["body"])
+	assert.NoError(t, err)
+	assert.Equal(t, "<p>Hello</p>", string(decodedBody))
+}
+
+func TestCampaigns_ResendToUnopened_NothingToResend(t *testing.T) {
+	campaignID := 10113867
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/campaigns/%d", apiBaseUrl, campaignID),
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 10113867, "name": "October news", "message": {"subject": "Our news"}}`))
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/campaigns/%d/recipients?limit=100&offset=0", apiBaseUrl, campaignID),
+		httpmock.NewStringResponder(http.StatusOK, `[{"email": "john@example.com", "opened": true}]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.Emails.Campaigns.ResendToUnopened(campaignID, "Did you miss it?")
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, ErrNothingToResend))
+
+	callCounts := httpmock.GetCallCountInfo()
+	assert.Equal(t, 0, callCounts["POST "+apiBaseUrl+"/addressbooks"])
+	assert.Equal(t, 0, callCounts["POST "+apiBaseUrl+"/campaigns"])
+}
+
+func TestCampaigns_ResendToUnopened_BadJson(t *testing.T) {
+	campaignID := 10113867
+	path := fmt.Sprintf("/campaigns/%d/recipients", campaignID)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/campaigns/%d", apiBaseUrl, campaignID),
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 10113867}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+path+"?limit=100&offset=0",
+		httpmock.NewStringResponder(http.StatusOK, `Invalid json`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Emails.Campaigns.ResendToUnopened(campaignID, "")
+	assert.Error(t, err)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.Equal(t, apiBaseUrl+path, spErr.Url)
+}
+
+func TestCampaigns_ResendToUnopened_TemplateBatched(t *testing.T) {
+	campaignID := 10113867
+	bookID := 2128930
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/campaigns/%d", apiBaseUrl, campaignID),
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 10113867, "name": "October news",
+			"message": {"sender_name": "Shop", "sender_email": "news@example.com", "subject": "Our news", "body": "", "template_id": 345}}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/template/345",
+		httpmock.NewStringResponder(http.StatusOK, fmt.Sprintf(`{"id": 345, "name": "News", "body": "%s"}`,
+			b64.StdEncoding.EncodeToString([]byte("<p>From template</p>")))))
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/campaigns/%d/recipients", apiBaseUrl, campaignID),
+		func(req *http.Request) (*http.Response, error) {
+			recipients := make([]map[string]interface{}, 0)
+			if req.URL.Query().Get("offset") == "0" {
+				for i := 0; i < 100; i++ {
+					recipients = append(recipients, map[string]interface{}{"email": fmt.Sprintf("user%d@example.com", i), "opened": 0})
+				}
+			} else if req.URL.Query().Get("offset") == "100" {
+				for i := 100; i < 150; i++ {
+					recipients = append(recipients, map[string]interface{}{"email": fmt.Sprintf("user%d@example.com", i), "opened": 0})
+				}
+			}
+			encoded, _ := json.Marshal(recipients)
+			return httpmock.NewStringResponse(http.StatusOK, string(encoded)), nil
+		})
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusOK, fmt.Sprintf(`{"id": %d}`, bookID)))
+
+	var addedCount int
+	var mu sync.Mutex
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/addressbooks/%d/emails", apiBaseUrl, bookID),
+		func(req *http.Request) (*http.Response, error) {
+			var payload struct {
+				Emails []Email `json:"emails"`
+			}
+			body, _ := ioutil.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &payload)
+			mu.Lock()
+			addedCount += len(payload.Emails)
+			mu.Unlock()
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	var campaignBody, templateID string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/campaigns",
+		func(req *http.Request) (*http.Response, error) {
+			_ = req.ParseForm()
+			campaignBody = req.PostForm.Get("body")
+			templateID = req.PostForm.Get("template_id")
+			return httpmock.NewStringResponse(http.StatusOK, `{"id": 10113870, "status": 13, "count": 150}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Emails.Campaigns.ResendToUnopened(campaignID, "")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 150, addedCount)
+	assert.Equal(t, 2, httpmock.GetCallCountInfo()[fmt.Sprintf("POST %s/addressbooks/%d/emails", apiBaseUrl, bookID)])
+	assert.Equal(t, "345", templateID)
+	decodedBody, err := b64.StdEncoding.DecodeString(campaignBody)
+	assert.NoError(t, err)
+	assert.Equal(t, "<p>From template</p>", string(decodedBody))
+}
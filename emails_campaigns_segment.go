@@ -0,0 +1,107 @@
+package sendpulse
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrEmptySegmentFilter is returned by SendToSegment when the filter has no conditions
+	ErrEmptySegmentFilter = errors.New("segment filter has no conditions")
+	// ErrInvalidSegmentCondition is returned when a filter condition has no variable or an unknown operator
+	ErrInvalidSegmentCondition = errors.New("invalid segment filter condition")
+)
+
+// SegmentOperator is the comparison of a variable in a segment filter condition
+type SegmentOperator string
+
+const (
+	SegmentEquals      SegmentOperator = "="
+	SegmentNotEquals   SegmentOperator = "!="
+	SegmentContains    SegmentOperator = "contains"
+	SegmentGreaterThan SegmentOperator = ">"
+	SegmentLessThan    SegmentOperator = "<"
+)
+
+// SegmentCondition compares the address book variable with Value
+type SegmentCondition struct {
+	Variable string
+	Operator SegmentOperator
+	Value    interface{}
+}
+
+// SegmentFilter selects the contacts of an address book matching all the conditions, or any of them if MatchAny is set
+type SegmentFilter struct {
+	Conditions []SegmentCondition
+	MatchAny   bool
+}
+
+func (f SegmentFilter) validate() error {
+	if len(f.Conditions) == 0 {
+		return ErrEmptySegmentFilter
+	}
+
+	for _, condition := range f.Conditions {
+		if condition.Variable == "" {
+			return ErrInvalidSegmentCondition
+		}
+		switch condition.Operator {
+		case SegmentEquals, SegmentNotEquals, SegmentContains, SegmentGreaterThan, SegmentLessThan:
+		default:
+			return ErrInvalidSegmentCondition
+		}
+	}
+	return nil
+}
+
+func (f SegmentFilter) payload() map[string]interface{} {
+	match := "all"
+	if f.MatchAny {
+		match = "any"
+	}
+
+	conditions := make([]map[string]interface{}, 0, len(f.Conditions))
+	for _, condition := range f.Conditions {
+		conditions = append(conditions, map[string]interface{}{
+			"variable": condition.Variable,
+			"operator": string(condition.Operator),
+			"value":    condition.Value,
+		})
+	}
+
+	return map[string]interface{}{
+		"match":      match,
+		"conditions": conditions,
+	}
+}
+
+// SendToSegment creates a campaign sent to the contacts of the address book matching the filter.
+// ListID and SegmentID of campaignData are ignored, and so is SendTestOnly
+func (c *campaigns) SendToSegment(bookID int, filter SegmentFilter, campaignData CreateCampaignData) (*CreatedCampaignData, error) {
+	return c.SendToSegmentContext(context.Background(), bookID, filter, campaignData)
+}
+
+func (c *campaigns) SendToSegmentContext(ctx context.Context, bookID int, filter SegmentFilter, campaignData CreateCampaignData) (*CreatedCampaignData, error) {
+	if err := filter.validate(); err != nil {
+		return nil, err
+	}
+
+	path := "/campaigns"
+
+	campaignData, err := c.withDefaultSender(ctx, campaignData)
+	if err != nil {
+		return nil, err
+	}
+
+	data := campaignData.params()
+	delete(data, "segment_id")
+	data["list_id"] = bookID
+	data["filter"] = filter.payload()
+
+	body, err := c.Client.makeJSONRequest(ctx, path, "POST", data)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.decodeCreatedCampaign("POST", path, body)
+}
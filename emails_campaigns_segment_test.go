@@ -0,0 +1,71 @@
+package sendpulse
+
+import (
+	"encoding/json"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestCampaigns_SendToSegment(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var payload map[string]interface{}
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/campaigns",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &payload)
+			return httpmock.NewStringResponse(http.StatusOK, `{"id": 10113872, "status": 13, "count": 120}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	filter := SegmentFilter{Conditions: []SegmentCondition{
+		{Variable: "country", Operator: SegmentEquals, Value: "US"},
+		{Variable: "age", Operator: SegmentGreaterThan, Value: 30},
+	}}
+	result, err := spClient.Emails.Campaigns.SendToSegment(2128929, filter, CreateCampaignData{
+		SenderName:  "Shop",
+		SenderEmail: "news@example.com",
+		Subject:     "Hello",
+		Body:        "<p>Hello</p>",
+		SegmentID:   5,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 10113872, result.ID)
+	assert.Equal(t, 120, result.Count)
+
+	assert.Equal(t, float64(2128929), payload["list_id"])
+	_, hasSegment := payload["segment_id"]
+	assert.False(t, hasSegment)
+	assert.Equal(t, map[string]interface{}{
+		"match": "all",
+		"conditions": []interface{}{
+			map[string]interface{}{"variable": "country", "operator": "=", "value": "US"},
+			map[string]interface{}{"variable": "age", "operator": ">", "value": float64(30)},
+		},
+	}, payload["filter"])
+}
+
+func TestCampaigns_SendToSegment_InvalidFilter(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Emails.Campaigns.SendToSegment(2128929, SegmentFilter{}, CreateCampaignData{})
+	assert.Equal(t, ErrEmptySegmentFilter, err)
+
+	_, err = spClient.Emails.Campaigns.SendToSegment(2128929, SegmentFilter{Conditions: []SegmentCondition{
+		{Variable: "age", Operator: ">=", Value: 30},
+	}}, CreateCampaignData{})
+	assert.Equal(t, ErrInvalidSegmentCondition, err)
+
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
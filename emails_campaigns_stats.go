@@ -0,0 +1,127 @@
+package sendpulse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Codes of the campaign statistics counters as reported by SendPulse
+const (
+	campaignStatSent         = 1
+	campaignStatDelivered    = 2
+	campaignStatOpened       = 3
+	campaignStatClicked      = 4
+	campaignStatUnsubscribed = 5
+	campaignStatBounced      = 6
+)
+
+// CampaignStats are the delivery counters of an email campaign
+type CampaignStats struct {
+	Sent         int
+	Delivered    int
+	Opened       int
+	Clicked      int
+	Bounced      int
+	Unsubscribed int
+}
+
+func (s *CampaignStats) add(statistics []CampaignStatisticsCounts) {
+	for _, stat := range statistics {
+		switch stat.Code {
+		case campaignStatSent:
+			s.Sent += stat.Count
+		case campaignStatDelivered:
+			s.Delivered += stat.Count
+		case campaignStatOpened:
+			s.Opened += stat.Count
+		case campaignStatClicked:
+			s.Clicked += stat.Count
+		case campaignStatUnsubscribed:
+			s.Unsubscribed += stat.Count
+		case campaignStatBounced:
+			s.Bounced += stat.Count
+		}
+	}
+}
+
+// CampaignsStatsError is returned by Campaigns.Stats when some campaigns couldn't be read, with the error of each one
+type CampaignsStatsError struct {
+	Failed map[int]error
+}
+
+func (e *CampaignsStatsError) Error() string {
+	ids := make([]int, 0, len(e.Failed))
+	for id := range e.Failed {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	messages := make([]string, 0, len(ids))
+	for _, id := range ids {
+		messages = append(messages, fmt.Sprintf("campaign %d: %s", id, e.Failed[id].Error()))
+	}
+	return fmt.Sprintf("%d campaigns failed: %s", len(ids), strings.Join(messages, "; "))
+}
+
+// Stats returns the statistics of the campaigns by their ids.
+// SendPulse has no batch endpoint, so every campaign is requested on its own, up to concurrency at once;
+// Config.RateLimiter applies to every request. If some campaigns fail the statistics of the others are
+// still returned along with *CampaignsStatsError
+func (c *campaigns) Stats(campaignIDs []int, concurrency int) (map[int]CampaignStats, error) {
+	return c.StatsContext(context.Background(), campaignIDs, concurrency)
+}
+
+func (c *campaigns) StatsContext(ctx context.Context, campaignIDs []int, concurrency int) (map[int]CampaignStats, error) {
+	if concurrency <= 0 {
+		return nil, errors.New("concurrency must be positive")
+	}
+
+	ids := make([]int, 0, len(campaignIDs))
+	seen := make(map[int]bool, len(campaignIDs))
+	for _, id := range campaignIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	stats := make(map[int]CampaignStats, len(ids))
+	statsErr := &CampaignsStatsError{Failed: make(map[int]error)}
+	var mu sync.Mutex
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency && i < len(ids); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				info, err := c.GetContext(ctx, id)
+
+				mu.Lock()
+				if err != nil {
+					statsErr.Failed[id] = err
+				} else {
+					campaignStats := CampaignStats{}
+					campaignStats.add(info.Statistics)
+					stats[id] = campaignStats
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, id := range ids {
+		jobs <- id
+	}
+	close(jobs)
+	wg.Wait()
+
+	if len(statsErr.Failed) != 0 {
+		return stats, statsErr
+	}
+	return stats, nil
+}
@@ -0,0 +1,56 @@
+package sendpulse
+
+import (
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestCampaigns_Stats(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	ids := []int{11, 12, 13, 14, 15}
+	for _, id := range ids {
+		httpmock.RegisterResponder("GET", fmt.Sprintf("%s/campaigns/%d", apiBaseUrl, id),
+			httpmock.NewStringResponder(http.StatusOK, fmt.Sprintf(`{"id": %d, "statistics": [
+				{"code": 1, "count": %d, "explain": "Sent"},
+				{"code": 3, "count": "%d", "explain": "Opened"}
+			]}`, id, id*10, id)))
+	}
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	stats, err := spClient.Emails.Campaigns.Stats(append(ids, 11), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, len(ids), len(stats))
+	for _, id := range ids {
+		assert.Equal(t, CampaignStats{Sent: id * 10, Opened: id}, stats[id])
+	}
+	assert.Equal(t, len(ids), httpmock.GetTotalCallCount())
+}
+
+func TestCampaigns_Stats_PartialFailure(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/11",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 11, "statistics": [{"code": 2, "count": 7, "explain": "Delivered"}]}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/campaigns/12",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"error_code": 404, "message": "Not found"}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	stats, err := spClient.Emails.Campaigns.Stats([]int{11, 12}, 4)
+	assert.Equal(t, map[int]CampaignStats{11: {Delivered: 7}}, stats)
+
+	statsErr, ok := err.(*CampaignsStatsError)
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(statsErr.Failed))
+	assert.Error(t, statsErr.Failed[12])
+}
@@ -0,0 +1,155 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Senders must be registered and verified before campaigns can be sent from their addresses.
+// The expected sequence is:
+//  1. Add registers the sender and SendPulse emails an activation code to it
+//  2. RequestActivationCode sends the code again if it got lost
+//  3. Activate confirms the sender with the received code
+type senders struct {
+	Client *client
+}
+
+var (
+	// ErrSenderAlreadyActive is returned by Activate when the sender is verified already
+	ErrSenderAlreadyActive = errors.New("sender is already activated")
+	// ErrInvalidActivationCode is returned by Activate when SendPulse rejects the code
+	ErrInvalidActivationCode = errors.New("invalid sender activation code")
+)
+
+type Sender struct {
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+	Status string `json:"status"`
+}
+
+// IsActive reports whether the sender is verified and can be used for campaigns
+func (s Sender) IsActive() bool {
+	return strings.EqualFold(s.Status, "active")
+}
+
+func (s *senders) List() ([]Sender, error) {
+	return s.ListContext(context.Background())
+}
+
+func (s *senders) ListContext(ctx context.Context) ([]Sender, error) {
+	path := "/senders"
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var sendersList []Sender
+	if err := json.Unmarshal(body, &sendersList); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	return sendersList, nil
+}
+
+// Add registers a sender. SendPulse emails the activation code to it
+func (s *senders) Add(name string, email string) error {
+	return s.AddContext(context.Background(), name, email)
+}
+
+func (s *senders) AddContext(ctx context.Context, name string, email string) error {
+	path := "/senders"
+
+	data := map[string]interface{}{
+		"name":  name,
+		"email": email,
+	}
+
+	body, err := s.Client.makeRequest(ctx, path, "POST", data, true)
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("POST", path, body)
+}
+
+func (s *senders) Delete(email string) error {
+	return s.DeleteContext(context.Background(), email)
+}
+
+func (s *senders) DeleteContext(ctx context.Context, email string) error {
+	path := "/senders"
+
+	data := map[string]interface{}{
+		"email": email,
+	}
+
+	body, err := s.Client.makeRequest(ctx, path, "DELETE", data, true)
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("DELETE", path, body)
+}
+
+// RequestActivationCode makes SendPulse email the activation code to the sender again
+func (s *senders) RequestActivationCode(email string) error {
+	return s.RequestActivationCodeContext(context.Background(), email)
+}
+
+func (s *senders) RequestActivationCodeContext(ctx context.Context, email string) error {
+	path := fmt.Sprintf("/senders/%s/code", url.PathEscape(email))
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("GET", path, body)
+}
+
+// Activate confirms the sender with the code from the activation email.
+// It returns ErrSenderAlreadyActive or ErrInvalidActivationCode if SendPulse refuses the activation
+func (s *senders) Activate(email string, code string) error {
+	return s.ActivateContext(context.Background(), email, code)
+}
+
+func (s *senders) ActivateContext(ctx context.Context, email string, code string) error {
+	path := fmt.Sprintf("/senders/%s/code", url.PathEscape(email))
+
+	data := map[string]interface{}{
+		"code": code,
+	}
+
+	body, err := s.Client.makeRequest(ctx, path, "POST", data, true)
+	if err == nil {
+		err = s.Client.checkResult("POST", path, body)
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	// Only a refused activation ({"result": false} or a validation error) is explained, other errors are returned as is
+	spErr, ok := err.(*SendpulseError)
+	if !ok || (spErr.HttpCode != http.StatusOK && !spErr.IsValidationError()) {
+		return err
+	}
+
+	// The API doesn't tell why the activation is refused, so the sender's state is checked
+	sendersList, listErr := s.ListContext(ctx)
+	if listErr != nil {
+		return err
+	}
+	for _, sender := range sendersList {
+		if strings.EqualFold(sender.Email, email) && sender.IsActive() {
+			return ErrSenderAlreadyActive
+		}
+	}
+	return ErrInvalidActivationCode
+}
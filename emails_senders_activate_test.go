@@ -0,0 +1,99 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestSenders_Activate_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var code string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/senders/shop@example.com/code",
+		func(req *http.Request) (*http.Response, error) {
+			req.ParseForm()
+			code = req.PostForm.Get("code")
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Senders.Activate("shop@example.com", "12345")
+	assert.NoError(t, err)
+	assert.Equal(t, "12345", code)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestSenders_Activate_InvalidCode(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/senders/shop@example.com/code",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": false}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/senders",
+		httpmock.NewStringResponder(http.StatusOK, `[{"name": "Shop", "email": "shop@example.com", "status": "Pending"}]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Senders.Activate("shop@example.com", "00000")
+	assert.Equal(t, ErrInvalidActivationCode, err)
+}
+
+func TestSenders_Activate_AlreadyActive(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/senders/shop@example.com/code",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"is_error": true, "message": "Sender is active"}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/senders",
+		httpmock.NewStringResponder(http.StatusOK, `[{"name": "Shop", "email": "shop@example.com", "status": "Active"}]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Senders.Activate("shop@example.com", "12345")
+	assert.Equal(t, ErrSenderAlreadyActive, err)
+}
+
+func TestSenders_Activate_ServerError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/senders/shop@example.com/code",
+		httpmock.NewStringResponder(http.StatusInternalServerError, ""))
+
+	config := Config{
+		UserID:      fake.CharactersN(50),
+		Secret:      fake.CharactersN(50),
+		Timeout:     5,
+		MaxAttempts: 1,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Senders.Activate("shop@example.com", "12345")
+	spErr, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+	assert.Equal(t, http.StatusInternalServerError, spErr.HttpCode)
+}
@@ -0,0 +1,57 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestSenders_Add_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var form url.Values
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/senders",
+		func(req *http.Request) (*http.Response, error) {
+			req.ParseForm()
+			form = req.PostForm
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Senders.Add("Shop", "shop@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "Shop", form.Get("name"))
+	assert.Equal(t, "shop@example.com", form.Get("email"))
+}
+
+func TestSenders_Add_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/senders",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"is_error": true, "message": "Sender already exists"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Senders.Add(fake.Word(), fake.EmailAddress())
+	spErr, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+	assert.Equal(t, "Sender already exists", spErr.ErrorDescription)
+}
@@ -0,0 +1,51 @@
+package sendpulse
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoActiveSender is returned when the account has no verified sender: one must be added with Senders.Add
+// and activated before campaigns can be sent
+var ErrNoActiveSender = errors.New("no active sender: add and activate a sender first")
+
+// Default returns the sender used for campaigns created without SenderEmail: the first verified sender of the account
+func (s *senders) Default() (*Sender, error) {
+	return s.DefaultContext(context.Background())
+}
+
+func (s *senders) DefaultContext(ctx context.Context) (*Sender, error) {
+	sendersList, err := s.ListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sender := range sendersList {
+		if sender.IsActive() {
+			return &sender, nil
+		}
+	}
+	return nil, ErrNoActiveSender
+}
+
+// withDefaultSender fills the empty sender email of the campaign with the default sender. A SenderName set by
+// the caller is kept, so the default address can be used under another name; otherwise the name of the default
+// sender is taken too. The default sender isn't cached, as it changes when senders are activated or removed:
+// each campaign created without SenderEmail costs an extra GET /senders, set SenderEmail to avoid it
+func (c *campaigns) withDefaultSender(ctx context.Context, campaignData CreateCampaignData) (CreateCampaignData, error) {
+	if campaignData.SenderEmail != "" {
+		return campaignData, nil
+	}
+
+	s := &senders{Client: c.Client}
+	sender, err := s.DefaultContext(ctx)
+	if err != nil {
+		return campaignData, err
+	}
+
+	campaignData.SenderEmail = sender.Email
+	if campaignData.SenderName == "" {
+		campaignData.SenderName = sender.Name
+	}
+	return campaignData, nil
+}
@@ -0,0 +1,78 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestSenders_Default(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/senders",
+		httpmock.NewStringResponder(http.StatusOK, `[
+			{"name": "Drafts", "email": "drafts@example.com", "status": "Inactive"},
+			{"name": "Shop", "email": "shop@example.com", "status": "Active"},
+			{"name": "News", "email": "news@example.com", "status": "Active"}
+		]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	sender, err := spClient.Emails.Senders.Default()
+	assert.NoError(t, err)
+	assert.Equal(t, &Sender{Name: "Shop", Email: "shop@example.com", Status: "Active"}, sender)
+}
+
+func TestSenders_Default_NoActiveSender(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/senders",
+		httpmock.NewStringResponder(http.StatusOK, `[{"name": "Drafts", "email": "drafts@example.com", "status": "Inactive"}]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Emails.Senders.Default()
+	assert.Equal(t, ErrNoActiveSender, err)
+
+	_, err = spClient.Emails.Campaigns.Create(CreateCampaignData{Subject: "Sale", Body: "<p>Sale</p>", ListID: 1})
+	assert.Equal(t, ErrNoActiveSender, err)
+	assert.Equal(t, 0, httpmock.GetCallCountInfo()["POST "+apiBaseUrl+"/campaigns"])
+}
+
+func TestCampaigns_Create_DefaultSender(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/senders",
+		httpmock.NewStringResponder(http.StatusOK, `[{"name": "Shop", "email": "shop@example.com", "status": "Active"}]`))
+
+	var form url.Values
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/campaigns",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			form, _ = url.ParseQuery(string(body))
+			return httpmock.NewStringResponse(http.StatusOK, `{"id": 1, "status": 13, "count": 1}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Emails.Campaigns.Create(CreateCampaignData{Subject: "Sale", Body: "<p>Sale</p>", ListID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, "shop@example.com", form.Get("sender_email"))
+	assert.Equal(t, "Shop", form.Get("sender_name"))
+
+	_, err = spClient.Emails.Campaigns.Create(CreateCampaignData{SenderName: "Shop news", Subject: "Sale", Body: "<p>Sale</p>", ListID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, "shop@example.com", form.Get("sender_email"))
+	assert.Equal(t, "Shop news", form.Get("sender_name"))
+	assert.Equal(t, 2, httpmock.GetCallCountInfo()["GET "+apiBaseUrl+"/senders"])
+}
@@ -0,0 +1,55 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestSenders_List_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/senders",
+		httpmock.NewStringResponder(http.StatusOK, `[{"name": "Shop", "email": "shop@example.com", "status": "Active"}, {"name": "News", "email": "news@example.com", "status": "Pending"}]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	sendersList, err := spClient.Emails.Senders.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []Sender{
+		{Name: "Shop", Email: "shop@example.com", Status: "Active"},
+		{Name: "News", Email: "news@example.com", Status: "Pending"},
+	}, sendersList)
+	assert.True(t, sendersList[0].IsActive())
+	assert.False(t, sendersList[1].IsActive())
+}
+
+func TestSenders_List_BadJson(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/senders",
+		httpmock.NewStringResponder(http.StatusOK, `Invalid json`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	sendersList, err := spClient.Emails.Senders.List()
+	assert.Nil(t, sendersList)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
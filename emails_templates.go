@@ -0,0 +1,169 @@
+package sendpulse
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type templates struct {
+	Client *client
+}
+
+// Owner filters for templates.List
+const (
+	TemplatesOwnerMe  = "me"
+	TemplatesOwnerAll = "all"
+)
+
+type Template struct {
+	ID          string
+	RealID      int
+	Name        string
+	Lang        string
+	Body        string
+	IsStructure bool
+	Owner       string
+	Created     time.Time
+}
+
+type templateRaw struct {
+	ID          interface{} `json:"id"`
+	RealID      interface{} `json:"real_id"`
+	Name        string      `json:"name"`
+	Lang        string      `json:"lang"`
+	Body        string      `json:"body"`
+	IsStructure bool        `json:"is_structure"`
+	Owner       string      `json:"owner"`
+	Created     string      `json:"created"`
+}
+
+func (raw templateRaw) template() Template {
+	tpl := Template{
+		ID:          toString(raw.ID),
+		RealID:      toInt(raw.RealID),
+		Name:        raw.Name,
+		Lang:        raw.Lang,
+		Body:        raw.Body,
+		IsStructure: raw.IsStructure,
+		Owner:       raw.Owner,
+	}
+	// The body is base64-encoded by the API, but it's still returned as is if it can't be decoded
+	if decoded, err := b64.StdEncoding.DecodeString(raw.Body); err == nil {
+		tpl.Body = string(decoded)
+	}
+	if created, err := time.Parse(sendDateLayout, raw.Created); err == nil {
+		tpl.Created = created
+	}
+	return tpl
+}
+
+// List returns templates filtered by the owner (TemplatesOwnerMe or TemplatesOwnerAll). An empty owner means no filter
+func (t *templates) List(owner string) ([]Template, error) {
+	return t.ListContext(context.Background(), owner)
+}
+
+func (t *templates) ListContext(ctx context.Context, owner string) ([]Template, error) {
+	path := "/templates"
+
+	var data map[string]interface{}
+	if owner != "" {
+		data = map[string]interface{}{
+			"owner": owner,
+		}
+	}
+
+	body, err := t.Client.makeRequest(ctx, path, "GET", data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var respData []templateRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, t.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	var templatesList []Template
+	for _, raw := range respData {
+		templatesList = append(templatesList, raw.template())
+	}
+	return templatesList, nil
+}
+
+func (t *templates) Get(templateID string) (*Template, error) {
+	return t.GetContext(context.Background(), templateID)
+}
+
+func (t *templates) GetContext(ctx context.Context, templateID string) (*Template, error) {
+	path := fmt.Sprintf("/template/%s", templateID)
+	ctx = withRoute(ctx, "/template/{id}")
+
+	body, err := t.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw templateRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, t.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	tpl := raw.template()
+	return &tpl, nil
+}
+
+// Create creates a template from plain HTML and returns its id
+func (t *templates) Create(name string, bodyHTML string, lang string) (string, error) {
+	return t.CreateContext(context.Background(), name, bodyHTML, lang)
+}
+
+func (t *templates) CreateContext(ctx context.Context, name string, bodyHTML string, lang string) (string, error) {
+	path := "/template"
+
+	data := map[string]interface{}{
+		"name": name,
+		"body": b64.StdEncoding.EncodeToString([]byte(bodyHTML)),
+		"lang": lang,
+	}
+
+	body, err := t.Client.makeRequest(ctx, path, "POST", data, true)
+	if err != nil {
+		return "", err
+	}
+
+	if err := t.Client.checkResult("POST", path, body); err != nil {
+		return "", err
+	}
+
+	var respData struct {
+		RealID interface{} `json:"real_id"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil || respData.RealID == nil {
+		return "", t.Client.invalidResponse("POST", path, body, "template id is missing")
+	}
+
+	return fmt.Sprint(toInt(respData.RealID)), nil
+}
+
+// Edit replaces the template body with plain HTML
+func (t *templates) Edit(templateID string, bodyHTML string) error {
+	return t.EditContext(context.Background(), templateID, bodyHTML)
+}
+
+func (t *templates) EditContext(ctx context.Context, templateID string, bodyHTML string) error {
+	path := fmt.Sprintf("/template/edit/%s", templateID)
+	ctx = withRoute(ctx, "/template/edit/{id}")
+
+	data := map[string]interface{}{
+		"body": b64.StdEncoding.EncodeToString([]byte(bodyHTML)),
+	}
+
+	body, err := t.Client.makeRequest(ctx, path, "POST", data, true)
+	if err != nil {
+		return err
+	}
+
+	return t.Client.checkResult("POST", path, body)
+}
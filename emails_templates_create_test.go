@@ -0,0 +1,60 @@
+package sendpulse
+
+import (
+	b64 "encoding/base64"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestTemplates_Create_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var form url.Values
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/template",
+		func(req *http.Request) (*http.Response, error) {
+			req.ParseForm()
+			form = req.PostForm
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "real_id": 1234}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	id, err := spClient.Emails.Templates.Create("Welcome", "<h1>Hello</h1>", "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "1234", id)
+	assert.Equal(t, "Welcome", form.Get("name"))
+	assert.Equal(t, "en", form.Get("lang"))
+	assert.Equal(t, b64.StdEncoding.EncodeToString([]byte("<h1>Hello</h1>")), form.Get("body"))
+}
+
+func TestTemplates_Create_InvalidResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/template",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	id, err := spClient.Emails.Templates.Create("Welcome", "<h1>Hello</h1>", "en")
+	assert.Equal(t, "", id)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
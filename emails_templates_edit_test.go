@@ -0,0 +1,55 @@
+package sendpulse
+
+import (
+	b64 "encoding/base64"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestTemplates_Edit_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var body string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/template/edit/1234",
+		func(req *http.Request) (*http.Response, error) {
+			req.ParseForm()
+			body = req.PostForm.Get("body")
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Templates.Edit("1234", "<h1>Bye</h1>")
+	assert.NoError(t, err)
+	assert.Equal(t, b64.StdEncoding.EncodeToString([]byte("<h1>Bye</h1>")), body)
+}
+
+func TestTemplates_Edit_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/template/edit/1234",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"error_code": 400, "message": "Invalid body"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Emails.Templates.Edit("1234", "")
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
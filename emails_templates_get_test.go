@@ -0,0 +1,55 @@
+package sendpulse
+
+import (
+	b64 "encoding/base64"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestTemplates_Get_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	body := b64.StdEncoding.EncodeToString([]byte("<p>Hi, {{name}}</p>"))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/template/1234",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 1234, "real_id": 1234, "name": "Welcome", "lang": "en", "body": "`+body+`", "is_structure": false, "created": "2020-02-27 15:35:35"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	tpl, err := spClient.Emails.Templates.Get("1234")
+	assert.NoError(t, err)
+	assert.Equal(t, "1234", tpl.ID)
+	assert.Equal(t, "<p>Hi, {{name}}</p>", tpl.Body)
+	assert.Equal(t, 2020, tpl.Created.Year())
+}
+
+func TestTemplates_Get_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/template/1234",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"error_code": 404, "message": "Template not found"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	tpl, err := spClient.Emails.Templates.Get("1234")
+	assert.Nil(t, tpl)
+	spErr, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+	assert.Equal(t, http.StatusNotFound, spErr.HttpCode)
+}
@@ -0,0 +1,86 @@
+package sendpulse
+
+import (
+	b64 "encoding/base64"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTemplates_List_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	body := b64.StdEncoding.EncodeToString([]byte("<h1>Hello</h1>"))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/templates?owner=me",
+		httpmock.NewStringResponder(http.StatusOK, `[{"id": "1234", "real_id": 1234, "name": "Welcome", "lang": "en", "body": "`+body+`", "is_structure": true, "owner": "me", "created": "2020-02-27 15:35:35"}]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	templatesList, err := spClient.Emails.Templates.List(TemplatesOwnerMe)
+	assert.NoError(t, err)
+	assert.Equal(t, []Template{{
+		ID:          "1234",
+		RealID:      1234,
+		Name:        "Welcome",
+		Lang:        "en",
+		Body:        "<h1>Hello</h1>",
+		IsStructure: true,
+		Owner:       "me",
+		Created:     time.Date(2020, 2, 27, 15, 35, 35, 0, time.UTC),
+	}}, templatesList)
+}
+
+func TestTemplates_List_NoOwner(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/templates",
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "", req.URL.RawQuery)
+			return httpmock.NewStringResponse(http.StatusOK, `[]`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	templatesList, err := spClient.Emails.Templates.List("")
+	assert.NoError(t, err)
+	assert.Empty(t, templatesList)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestTemplates_List_BadJson(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/templates",
+		httpmock.NewStringResponder(http.StatusOK, `Invalid json`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	templatesList, err := spClient.Emails.Templates.List("")
+	assert.Nil(t, templatesList)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
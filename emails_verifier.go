@@ -0,0 +1,144 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// verifier checks whether emails exist and can receive messages. Verification is asynchronous:
+// start it with StartValidation and poll ValidationResult until the report isn't InProgress
+type verifier struct {
+	Client *client
+}
+
+// Verification statuses of an email
+const (
+	EmailVerdictUnknown       = 0
+	EmailVerdictDeliverable   = 1
+	EmailVerdictUndeliverable = 2
+	EmailVerdictRisky         = 3
+)
+
+// ValidationReport is the result of an address book verification, counters are split by verification status
+type ValidationReport struct {
+	BookID        int
+	BookName      string
+	AllEmails     int
+	Deliverable   int
+	Undeliverable int
+	Risky         int
+	Unknown       int
+	CheckDate     time.Time
+	InProgress    bool
+}
+
+type validationReportRaw struct {
+	ID              interface{}            `json:"id"`
+	AddressBookName string                 `json:"address_book_name"`
+	AllEmails       interface{}            `json:"all_emails_quantity"`
+	Status          interface{}            `json:"status"`
+	CheckDate       string                 `json:"check_date"`
+	Data            map[string]interface{} `json:"data"`
+}
+
+// EmailVerdict is the verification result of a single email. Status is one of EmailVerdict* constants
+type EmailVerdict struct {
+	Email      string
+	Status     int
+	InProgress bool
+}
+
+// StartValidation starts the verification of all emails of the address book
+func (v *verifier) StartValidation(addressBookID int) error {
+	return v.StartValidationContext(context.Background(), addressBookID)
+}
+
+func (v *verifier) StartValidationContext(ctx context.Context, addressBookID int) error {
+	path := fmt.Sprintf("/addressbooks/%d/verify", addressBookID)
+
+	body, err := v.Client.makeRequest(ctx, path, "POST", nil, true)
+	if err != nil {
+		return err
+	}
+
+	return v.Client.checkResult("POST", path, body)
+}
+
+// ValidationResult returns the verification report of the address book. InProgress is set until the verification finishes
+func (v *verifier) ValidationResult(addressBookID int) (*ValidationReport, error) {
+	return v.ValidationResultContext(context.Background(), addressBookID)
+}
+
+func (v *verifier) ValidationResultContext(ctx context.Context, addressBookID int) (*ValidationReport, error) {
+	path := fmt.Sprintf("/addressbooks/%d/verify", addressBookID)
+
+	body, err := v.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw validationReportRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, v.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	report := ValidationReport{
+		BookID:        toInt(raw.ID),
+		BookName:      raw.AddressBookName,
+		AllEmails:     toInt(raw.AllEmails),
+		Deliverable:   toInt(raw.Data[fmt.Sprint(EmailVerdictDeliverable)]),
+		Undeliverable: toInt(raw.Data[fmt.Sprint(EmailVerdictUndeliverable)]),
+		Risky:         toInt(raw.Data[fmt.Sprint(EmailVerdictRisky)]),
+		Unknown:       toInt(raw.Data[fmt.Sprint(EmailVerdictUnknown)]),
+		// 0 is a new verification, 1 is in progress and 2 is done
+		InProgress: toInt(raw.Status) != 2,
+	}
+	if checkDate, err := time.Parse(sendDateLayout, raw.CheckDate); err == nil {
+		report.CheckDate = checkDate
+	}
+	return &report, nil
+}
+
+// VerifyEmail sends the email to verification and returns its result. If SendPulse hasn't checked the email yet,
+// the verdict is InProgress and VerifyEmail should be called again later
+func (v *verifier) VerifyEmail(email string) (*EmailVerdict, error) {
+	return v.VerifyEmailContext(context.Background(), email)
+}
+
+func (v *verifier) VerifyEmailContext(ctx context.Context, email string) (*EmailVerdict, error) {
+	path := "/verifier-service/send-single-to-verify"
+
+	body, err := v.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"email": email,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := v.Client.checkResult("POST", path, body); err != nil {
+		return nil, err
+	}
+
+	path = "/verifier-service/get-single-result"
+	body, err = v.Client.makeRequest(ctx, path, "GET", map[string]interface{}{"email": email}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var respData struct {
+		Result bool `json:"result"`
+		Data   *struct {
+			Email  string      `json:"email"`
+			Status interface{} `json:"status"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, v.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	if respData.Data == nil || respData.Data.Status == nil {
+		return &EmailVerdict{Email: email, InProgress: true}, nil
+	}
+	return &EmailVerdict{Email: email, Status: toInt(respData.Data.Status)}, nil
+}
@@ -0,0 +1,133 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestVerifier_StartValidation_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/addressbooks/1/verify",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	assert.NoError(t, spClient.Emails.Verifier.StartValidation(1))
+}
+
+func TestVerifier_ValidationResult_InProgress(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks/1/verify",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 1, "address_book_name": "Customers", "all_emails_quantity": 300, "status": 1, "data": {}}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	report, err := spClient.Emails.Verifier.ValidationResult(1)
+	assert.NoError(t, err)
+	assert.True(t, report.InProgress)
+	assert.Equal(t, 300, report.AllEmails)
+	assert.Equal(t, 0, report.Deliverable)
+}
+
+func TestVerifier_ValidationResult_Completed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks/1/verify",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"id": 1, "address_book_name": "Customers", "all_emails_quantity": "300", "status": 2,
+			"check_date": "2021-06-01 12:00:00", "data": {"0": 5, "1": "250", "2": 30, "3": 15}
+		}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	report, err := spClient.Emails.Verifier.ValidationResult(1)
+	assert.NoError(t, err)
+	assert.Equal(t, ValidationReport{
+		BookID:        1,
+		BookName:      "Customers",
+		AllEmails:     300,
+		Deliverable:   250,
+		Undeliverable: 30,
+		Risky:         15,
+		Unknown:       5,
+		CheckDate:     time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+	}, *report)
+}
+
+func TestVerifier_VerifyEmail_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/verifier-service/send-single-to-verify",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/verifier-service/get-single-result?email=john@example.com",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true, "data": {"email": "john@example.com", "status": 1}}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	verdict, err := spClient.Emails.Verifier.VerifyEmail("john@example.com")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"email": "john@example.com"}`, requestBody)
+	assert.Equal(t, EmailVerdict{Email: "john@example.com", Status: EmailVerdictDeliverable}, *verdict)
+}
+
+func TestVerifier_VerifyEmail_InProgress(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/verifier-service/send-single-to-verify",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/verifier-service/get-single-result?email=john@example.com",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true, "data": null}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	verdict, err := spClient.Emails.Verifier.VerifyEmail("john@example.com")
+	assert.NoError(t, err)
+	assert.True(t, verdict.InProgress)
+}
@@ -0,0 +1,62 @@
+package sendpulse
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Environment variables read by NewClientFromEnv
+const (
+	EnvUserID  = "SENDPULSE_USER_ID"
+	EnvSecret  = "SENDPULSE_SECRET"
+	EnvTimeout = "SENDPULSE_TIMEOUT"
+	EnvBaseURL = "SENDPULSE_BASE_URL"
+)
+
+// NewClientFromEnv creates the client with the credentials from SENDPULSE_USER_ID and SENDPULSE_SECRET,
+// and optionally the timeout in seconds from SENDPULSE_TIMEOUT and the API address from SENDPULSE_BASE_URL.
+// The options are applied over the configuration read from the environment.
+// It returns the same *SendpulseClient as ApiClient, with all the services, rather than the bare HTTP client of NewClient
+func NewClientFromEnv(opts ...Option) (*SendpulseClient, error) {
+	config, err := configFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return ApiClient(config, opts...)
+}
+
+// ApiClientFromEnv is NewClientFromEnv named after ApiClient
+func ApiClientFromEnv(opts ...Option) (*SendpulseClient, error) {
+	return NewClientFromEnv(opts...)
+}
+
+func configFromEnv() (Config, error) {
+	config := Config{
+		UserID:  strings.TrimSpace(os.Getenv(EnvUserID)),
+		Secret:  strings.TrimSpace(os.Getenv(EnvSecret)),
+		BaseURL: strings.TrimSpace(os.Getenv(EnvBaseURL)),
+	}
+
+	var missing []string
+	if config.UserID == "" {
+		missing = append(missing, EnvUserID)
+	}
+	if config.Secret == "" {
+		missing = append(missing, EnvSecret)
+	}
+	if len(missing) != 0 {
+		return Config{}, fmt.Errorf("missing environment variables: %s", strings.Join(missing, ", "))
+	}
+
+	if timeout := strings.TrimSpace(os.Getenv(EnvTimeout)); timeout != "" {
+		seconds, err := strconv.Atoi(timeout)
+		if err != nil || seconds <= 0 {
+			return Config{}, fmt.Errorf("invalid %s: %q is not a positive number of seconds", EnvTimeout, timeout)
+		}
+		config.Timeout = seconds
+	}
+
+	return config, nil
+}
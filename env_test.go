@@ -0,0 +1,65 @@
+package sendpulse
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewClientFromEnv(t *testing.T) {
+	t.Setenv(EnvUserID, "user-id")
+	t.Setenv(EnvSecret, "secret")
+	t.Setenv(EnvTimeout, "12")
+	t.Setenv(EnvBaseURL, "https://sendpulse.example.com/")
+
+	spClient, err := NewClientFromEnv(WithUserAgent("my-app/1.0"))
+	assert.NoError(t, err)
+	assert.Equal(t, "user-id", spClient.client.config.UserID)
+	assert.Equal(t, "secret", spClient.client.config.Secret)
+	assert.Equal(t, 12*time.Second, spClient.client.httpClient.Timeout)
+	assert.Equal(t, "https://sendpulse.example.com", spClient.client.config.BaseURL)
+	assert.Equal(t, "my-app/1.0", spClient.client.config.UserAgent)
+}
+
+func TestApiClientFromEnv(t *testing.T) {
+	t.Setenv(EnvUserID, "user-id")
+	t.Setenv(EnvSecret, "secret")
+
+	spClient, err := ApiClientFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, "user-id", spClient.client.config.UserID)
+}
+
+func TestNewClientFromEnv_Defaults(t *testing.T) {
+	t.Setenv(EnvUserID, "user-id")
+	t.Setenv(EnvSecret, "secret")
+	t.Setenv(EnvTimeout, "")
+	t.Setenv(EnvBaseURL, "")
+
+	spClient, err := NewClientFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, 5, spClient.client.config.Timeout)
+	assert.Equal(t, apiBaseUrl, spClient.client.config.BaseURL)
+}
+
+func TestNewClientFromEnv_MissingSecret(t *testing.T) {
+	t.Setenv(EnvUserID, "user-id")
+	t.Setenv(EnvSecret, "")
+
+	spClient, err := NewClientFromEnv()
+	assert.Nil(t, spClient)
+	assert.EqualError(t, err, "missing environment variables: SENDPULSE_SECRET")
+
+	t.Setenv(EnvUserID, "")
+	_, err = NewClientFromEnv()
+	assert.EqualError(t, err, "missing environment variables: SENDPULSE_USER_ID, SENDPULSE_SECRET")
+}
+
+func TestNewClientFromEnv_InvalidTimeout(t *testing.T) {
+	t.Setenv(EnvUserID, "user-id")
+	t.Setenv(EnvSecret, "secret")
+	t.Setenv(EnvTimeout, "soon")
+
+	_, err := NewClientFromEnv()
+	assert.EqualError(t, err, `invalid SENDPULSE_TIMEOUT: "soon" is not a positive number of seconds`)
+}
@@ -0,0 +1,43 @@
+package sendpulse
+
+import (
+	"context"
+	"net/http"
+)
+
+type headersContextKey struct{}
+
+// protectedHeaders are set by the client and can't be overridden by extra headers
+var protectedHeaders = map[string]bool{
+	"Authorization": true,
+	"Content-Type":  true,
+	"User-Agent":    true,
+}
+
+// ContextWithHeaders returns a copy of ctx carrying extra headers for the requests made with it,
+// e.g. X-Request-ID for tracing. They're added over the ones set by WithDefaultHeaders.
+// Authorization, Content-Type and User-Agent are ignored
+func ContextWithHeaders(ctx context.Context, header http.Header) context.Context {
+	return context.WithValue(ctx, headersContextKey{}, header.Clone())
+}
+
+// setExtraHeaders adds the default headers and the ones carried by ctx to req, skipping the protected ones
+func (c *client) setExtraHeaders(ctx context.Context, req *http.Request) {
+	sources := []http.Header{c.config.DefaultHeaders}
+	if header, ok := ctx.Value(headersContextKey{}).(http.Header); ok {
+		sources = append(sources, header)
+	}
+
+	for _, header := range sources {
+		for name, values := range header {
+			name = http.CanonicalHeaderKey(name)
+			if protectedHeaders[name] {
+				continue
+			}
+			req.Header.Del(name)
+			for _, value := range values {
+				req.Header.Add(name, value)
+			}
+		}
+	}
+}
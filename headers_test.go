@@ -0,0 +1,67 @@
+package sendpulse
+
+import (
+	"context"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestClient_MakeRequest_ExtraHeaders(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var received http.Header
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/addressbooks",
+		func(req *http.Request) (*http.Response, error) {
+			received = req.Header
+			return httpmock.NewStringResponse(http.StatusOK, `{"id": 1}`), nil
+		})
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5}, WithDefaultHeaders(http.Header{
+		"X-Service":     []string{"billing"},
+		"X-Request-Id":  []string{"default"},
+		"Authorization": []string{"Basic bad"},
+		"user-agent":    []string{"spoofed"},
+	}))
+	c.token = "testtoken"
+
+	ctx := ContextWithHeaders(context.Background(), http.Header{
+		"X-Request-Id": []string{"req-42"},
+		"Content-Type": []string{"text/plain"},
+	})
+	_, err := c.makeRequest(ctx, "/addressbooks", "POST", map[string]interface{}{"bookName": "test"}, true)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "billing", received.Get("X-Service"))
+	assert.Equal(t, []string{"req-42"}, received.Values("X-Request-Id"))
+	assert.Equal(t, "Bearer testtoken", received.Get("Authorization"))
+	assert.Equal(t, []string{"sendpulse-sdk-go/" + Version}, received.Values("User-Agent"))
+	assert.Equal(t, "application/x-www-form-urlencoded; param=value", received.Get("Content-Type"))
+}
+
+func TestClient_MakeRequest_ExtraHeadersTokenRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var tokenHeader http.Header
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		func(req *http.Request) (*http.Response, error) {
+			tokenHeader = req.Header
+			return httpmock.NewStringResponse(http.StatusOK,
+				`{"access_token": "testtoken","token_type": "Bearer","expires_in": 3600}`), nil
+		})
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5},
+		WithDefaultHeaders(http.Header{"Authorization": []string{"Basic bad"}}))
+	ctx := ContextWithHeaders(context.Background(), http.Header{"X-Request-Id": []string{"req-42"}})
+	_, err := c.makeRequest(ctx, "/addressbooks", "GET", nil, true)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "req-42", tokenHeader.Get("X-Request-Id"))
+	assert.Equal(t, "", tokenHeader.Get("Authorization"))
+}
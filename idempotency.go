@@ -0,0 +1,85 @@
+package sendpulse
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+)
+
+// idempotencyTTL is how long a successful send is remembered for its idempotency key
+const idempotencyTTL = 10 * time.Minute
+
+// idempotentCall is a send made with an idempotency key. Waiting goroutines get its result once done is closed
+type idempotentCall struct {
+	done    chan struct{}
+	result  interface{}
+	err     error
+	expires time.Time
+}
+
+// idempotencyCache remembers the results of the sends made with an idempotency key.
+// SendPulse doesn't deduplicate requests, so it's done on the client side: only sends made through the same
+// SendpulseClient within idempotencyTTL are deduplicated, not those of other processes or after a restart
+type idempotencyCache struct {
+	lock  sync.Mutex
+	calls map[string]*idempotentCall
+}
+
+// idempotencyKey combines the caller key with the hash of the request payload,
+// so a key reused for a different message doesn't suppress it
+func idempotencyKey(key string, payload []byte) string {
+	sum := sha256.Sum256(payload)
+	return key + ":" + hex.EncodeToString(sum[:])
+}
+
+// do calls fn unless a call with the same key succeeded within idempotencyTTL or is in flight, in which case
+// its result is returned. Failed calls aren't remembered, so they can be retried. Waiting for a call in flight
+// stops when ctx is done, the call itself goes on for its own caller
+func (cache *idempotencyCache) do(ctx context.Context, key string, fn func() (interface{}, error)) (interface{}, error) {
+	for {
+		now := time.Now()
+
+		cache.lock.Lock()
+		if cache.calls == nil {
+			cache.calls = make(map[string]*idempotentCall)
+		}
+		for k, call := range cache.calls {
+			if !call.expires.IsZero() && now.After(call.expires) {
+				delete(cache.calls, k)
+			}
+		}
+		if call, ok := cache.calls[key]; ok {
+			cache.lock.Unlock()
+			select {
+			case <-call.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+
+			// The caller which made the send was canceled, but this one may still go on and send it itself
+			if call.err != nil && (errors.Is(call.err, context.Canceled) || errors.Is(call.err, context.DeadlineExceeded)) {
+				continue
+			}
+			return call.result, call.err
+		}
+		call := &idempotentCall{done: make(chan struct{})}
+		cache.calls[key] = call
+		cache.lock.Unlock()
+
+		call.result, call.err = fn()
+
+		cache.lock.Lock()
+		if call.err != nil {
+			delete(cache.calls, key)
+		} else {
+			call.expires = time.Now().Add(idempotencyTTL)
+		}
+		cache.lock.Unlock()
+		close(call.done)
+
+		return call.result, call.err
+	}
+}
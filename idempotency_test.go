@@ -0,0 +1,110 @@
+package sendpulse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIdempotencyCache_Concurrent(t *testing.T) {
+	var cache idempotencyCache
+	var calls int32
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := cache.do(context.Background(), "key", func() (interface{}, error) {
+				atomic.AddInt32(&calls, 1)
+				time.Sleep(20 * time.Millisecond)
+				return "sent", nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, "sent", result)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestIdempotencyCache_Expired(t *testing.T) {
+	var cache idempotencyCache
+	calls := 0
+	fn := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	_, _ = cache.do(context.Background(), "key", fn)
+	cache.calls["key"].expires = time.Now().Add(-time.Second)
+	result, _ := cache.do(context.Background(), "key", fn)
+
+	assert.Equal(t, 2, result)
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotencyCache_WaitCanceled(t *testing.T) {
+	var cache idempotencyCache
+	release := make(chan struct{})
+	started := make(chan struct{})
+	go func() {
+		_, _ = cache.do(context.Background(), "key", func() (interface{}, error) {
+			close(started)
+			<-release
+			return "sent", nil
+		})
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := cache.do(ctx, "key", func() (interface{}, error) {
+		return "sent again", nil
+	})
+	assert.Equal(t, context.DeadlineExceeded, err)
+	close(release)
+}
+
+func TestIdempotencyCache_FirstCallerCanceled(t *testing.T) {
+	var cache idempotencyCache
+	started := make(chan struct{})
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+
+	firstDone := make(chan error)
+	go func() {
+		_, err := cache.do(firstCtx, "key", func() (interface{}, error) {
+			close(started)
+			<-firstCtx.Done()
+			return nil, fmt.Errorf("POST /smtp/emails: %w", firstCtx.Err())
+		})
+		firstDone <- err
+	}()
+	<-started
+
+	secondDone := make(chan interface{})
+	go func() {
+		result, err := cache.do(context.Background(), "key", func() (interface{}, error) {
+			return "sent", nil
+		})
+		assert.NoError(t, err)
+		secondDone <- result
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancelFirst()
+
+	assert.True(t, errors.Is(<-firstDone, context.Canceled))
+	assert.Equal(t, "sent", <-secondDone)
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	assert.Equal(t, idempotencyKey("order-1", []byte(`{"a":1}`)), idempotencyKey("order-1", []byte(`{"a":1}`)))
+	assert.NotEqual(t, idempotencyKey("order-1", []byte(`{"a":1}`)), idempotencyKey("order-1", []byte(`{"a":2}`)))
+}
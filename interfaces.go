@@ -0,0 +1,386 @@
+package sendpulse
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// The interfaces below list the methods of every service so code calling SendPulse can depend on them
+// and get a mock in tests, e.g. a handler accepting SMSService is given &client.SMS in production.
+// Keep them in sync with the services when adding methods
+
+// BooksService is implemented by Emails.Books
+type BooksService interface {
+	Create(addressBookName string) (*int, error)
+	CreateContext(ctx context.Context, addressBookName string) (*int, error)
+	Update(addressBookId int, name string) error
+	UpdateContext(ctx context.Context, addressBookId int, name string) error
+	List(limit int, offset int) ([]Book, error)
+	ListContext(ctx context.Context, limit int, offset int) ([]Book, error)
+	Find(nameContains string, limit int, offset int) ([]Book, error)
+	FindContext(ctx context.Context, nameContains string, limit int, offset int) ([]Book, error)
+	Get(addressBookId int) (*Book, error)
+	GetContext(ctx context.Context, addressBookId int) (*Book, error)
+	Variables(addressBookId int) ([]Variable, error)
+	VariablesContext(ctx context.Context, addressBookId int) ([]Variable, error)
+	Emails(addressBookId int, limit int, offset int) ([]Contact, error)
+	EmailsContext(ctx context.Context, addressBookId int, limit int, offset int) ([]Contact, error)
+	EmailsByVariable(addressBookId int, variableName string, value interface{}) ([]Contact, error)
+	EmailsByVariableContext(ctx context.Context, addressBookId int, variableName string, value interface{}) ([]Contact, error)
+	EmailInfo(addressBookId int, email string) (*Contact, error)
+	EmailInfoContext(ctx context.Context, addressBookId int, email string) (*Contact, error)
+	EmailGlobalInfo(email string) (map[int]Contact, error)
+	EmailGlobalInfoContext(ctx context.Context, email string) (map[int]Contact, error)
+	IterateEmails(addressBookId int, batchSize int, fn func(Contact) error) error
+	IterateEmailsContext(ctx context.Context, addressBookId int, batchSize int, fn func(Contact) error) error
+	EmailsTotal(addressBookId int) (int, error)
+	EmailsTotalContext(ctx context.Context, addressBookId int) (int, error)
+	AddEmails(addressBookId int, notifications []Email, additionalParams map[string]string, senderEmail string) error
+	AddEmailsContext(ctx context.Context, addressBookId int, notifications []Email, additionalParams map[string]string, senderEmail string) error
+	DeleteEmails(addressBookId int, emailsList []string) error
+	DeleteEmailsContext(ctx context.Context, addressBookId int, emailsList []string) error
+	UnsubscribeEmails(addressBookId int, emailsList []string) error
+	UnsubscribeEmailsContext(ctx context.Context, addressBookId int, emailsList []string) error
+	UpdateEmailsStatus(addressBookId int, emailsList []string, status ContactStatus) error
+	UpdateEmailsStatusContext(ctx context.Context, addressBookId int, emailsList []string, status ContactStatus) error
+	Delete(addressBookId int) error
+	DeleteContext(ctx context.Context, addressBookId int) error
+	CampaignCost(addressBookId int) (*CampaignCost, error)
+	CampaignCostContext(ctx context.Context, addressBookId int) (*CampaignCost, error)
+	Campaigns(bookID int, limit int, offset int) ([]Task, error)
+	CampaignsContext(ctx context.Context, bookID int, limit int, offset int) ([]Task, error)
+	Segments(bookID int) ([]Segment, error)
+	SegmentsContext(ctx context.Context, bookID int) ([]Segment, error)
+	EmailActivity(addressBookId int, email string) (*EmailActivity, error)
+	EmailActivityContext(ctx context.Context, addressBookId int, email string) (*EmailActivity, error)
+	AddEmailsBatched(addressBookId int, emails []Email, batchSize int, concurrency int) error
+	AddEmailsBatchedContext(ctx context.Context, addressBookId int, emails []Email, batchSize int, concurrency int) error
+	Count() (int, error)
+	CountContext(ctx context.Context) (int, error)
+	ListPage(limit int, offset int) ([]Book, int, error)
+	ListPageContext(ctx context.Context, limit int, offset int) ([]Book, int, error)
+	ImportEmailsFromCSV(addressBookId int, r io.Reader, hasHeader bool, columnMap map[string]string) (*ImportResult, error)
+	ImportEmailsFromCSVContext(ctx context.Context, addressBookId int, r io.Reader, hasHeader bool, columnMap map[string]string) (*ImportResult, error)
+	ExportEmailsToCSV(addressBookId int, w io.Writer, columns []string) error
+	ExportEmailsToCSVContext(ctx context.Context, addressBookId int, w io.Writer, columns []string) error
+	CopyEmails(fromBookID int, toBookID int, emailsList []string) error
+	CopyEmailsContext(ctx context.Context, fromBookID int, toBookID int, emailsList []string) error
+	MoveEmails(fromBookID int, toBookID int, emailsList []string) error
+	MoveEmailsContext(ctx context.Context, fromBookID int, toBookID int, emailsList []string) error
+}
+
+// Automation360Service is implemented by Emails.Automation360
+type Automation360Service interface {
+	StartEvent(eventName string, variables map[string]interface{}) error
+	StartEventContext(ctx context.Context, eventName string, variables map[string]interface{}) error
+	SendEvent(eventName string, email string, phone string, variables map[string]interface{}) error
+	SendEventContext(ctx context.Context, eventName string, email string, phone string, variables map[string]interface{}) error
+	List() ([]Automation, error)
+	ListContext(ctx context.Context) ([]Automation, error)
+	Get(automationID int) (*AutomationDetail, error)
+	GetContext(ctx context.Context, automationID int) (*AutomationDetail, error)
+	Start(automationID int) error
+	StartContext(ctx context.Context, automationID int) error
+	Stop(automationID int) error
+	StopContext(ctx context.Context, automationID int) error
+}
+
+// CampaignsService is implemented by Emails.Campaigns
+type CampaignsService interface {
+	Create(campaignData CreateCampaignData) (*CreatedCampaignData, error)
+	CreateContext(ctx context.Context, campaignData CreateCampaignData) (*CreatedCampaignData, error)
+	Update(campaignData UpdateCampaignData) error
+	UpdateContext(ctx context.Context, campaignData UpdateCampaignData) error
+	Get(campaignID int) (*CampaignFullInfo, error)
+	GetContext(ctx context.Context, campaignID int) (*CampaignFullInfo, error)
+	List(limit int, offset int) ([]CampaignInfo, error)
+	ListContext(ctx context.Context, limit int, offset int) ([]CampaignInfo, error)
+	Countries(campaignID int) (map[string]int, error)
+	CountriesContext(ctx context.Context, campaignID int) (map[string]int, error)
+	Referrals(campaignID int) ([]ReferralsStatistics, error)
+	ReferralsContext(ctx context.Context, campaignID int) ([]ReferralsStatistics, error)
+	Cancel(campaignID int) error
+	CancelContext(ctx context.Context, campaignID int) error
+	CreateABCampaign(params ABCampaignParams) (*ABCampaignResult, error)
+	CreateABCampaignContext(ctx context.Context, params ABCampaignParams) (*ABCampaignResult, error)
+	Content(campaignID int) (string, error)
+	ContentContext(ctx context.Context, campaignID int) (string, error)
+	SendDraft(campaignID int) error
+	SendDraftContext(ctx context.Context, campaignID int) error
+	Recipients(campaignID int, status string, limit int, offset int) ([]RecipientStat, error)
+	RecipientsContext(ctx context.Context, campaignID int, status string, limit int, offset int) ([]RecipientStat, error)
+	IterateRecipients(campaignID int, status string, batchSize int, fn func(RecipientStat) error) error
+	IterateRecipientsContext(ctx context.Context, campaignID int, status string, batchSize int, fn func(RecipientStat) error) error
+	ResendToUnopened(campaignID int, newSubject string) (*CreatedCampaignData, error)
+	ResendToUnopenedContext(ctx context.Context, campaignID int, newSubject string) (*CreatedCampaignData, error)
+	SendToSegment(bookID int, filter SegmentFilter, campaignData CreateCampaignData) (*CreatedCampaignData, error)
+	SendToSegmentContext(ctx context.Context, bookID int, filter SegmentFilter, campaignData CreateCampaignData) (*CreatedCampaignData, error)
+	Stats(campaignIDs []int, concurrency int) (map[int]CampaignStats, error)
+	StatsContext(ctx context.Context, campaignIDs []int, concurrency int) (map[int]CampaignStats, error)
+}
+
+// BlacklistService is implemented by Emails.Blacklist
+type BlacklistService interface {
+	Add(emails []string, comment string) error
+	AddContext(ctx context.Context, emails []string, comment string) error
+	Remove(emails []string) error
+	RemoveContext(ctx context.Context, emails []string) error
+	List() ([]string, error)
+	ListContext(ctx context.Context) ([]string, error)
+}
+
+// SendersService is implemented by Emails.Senders
+type SendersService interface {
+	List() ([]Sender, error)
+	ListContext(ctx context.Context) ([]Sender, error)
+	Add(name string, email string) error
+	AddContext(ctx context.Context, name string, email string) error
+	Delete(email string) error
+	DeleteContext(ctx context.Context, email string) error
+	RequestActivationCode(email string) error
+	RequestActivationCodeContext(ctx context.Context, email string) error
+	Activate(email string, code string) error
+	ActivateContext(ctx context.Context, email string, code string) error
+	Default() (*Sender, error)
+	DefaultContext(ctx context.Context) (*Sender, error)
+}
+
+// TemplatesService is implemented by Emails.Templates
+type TemplatesService interface {
+	List(owner string) ([]Template, error)
+	ListContext(ctx context.Context, owner string) ([]Template, error)
+	Get(templateID string) (*Template, error)
+	GetContext(ctx context.Context, templateID string) (*Template, error)
+	Create(name string, bodyHTML string, lang string) (string, error)
+	CreateContext(ctx context.Context, name string, bodyHTML string, lang string) (string, error)
+	Edit(templateID string, bodyHTML string) error
+	EditContext(ctx context.Context, templateID string, bodyHTML string) error
+}
+
+// VerifierService is implemented by Emails.Verifier
+type VerifierService interface {
+	StartValidation(addressBookID int) error
+	StartValidationContext(ctx context.Context, addressBookID int) error
+	ValidationResult(addressBookID int) (*ValidationReport, error)
+	ValidationResultContext(ctx context.Context, addressBookID int) (*ValidationReport, error)
+	VerifyEmail(email string) (*EmailVerdict, error)
+	VerifyEmailContext(ctx context.Context, email string) (*EmailVerdict, error)
+}
+
+// BalanceService is implemented by SendpulseClient.Balance
+type BalanceService interface {
+	Get(currency string) (*Balance, error)
+	GetContext(ctx context.Context, currency string) (*Balance, error)
+	GetDetailed() (*DetailedBalance, error)
+	GetDetailedContext(ctx context.Context) (*DetailedBalance, error)
+}
+
+// AccountService is implemented by SendpulseClient.Account
+type AccountService interface {
+	Get() (*AccountInfo, error)
+	GetContext(ctx context.Context) (*AccountInfo, error)
+	EmailStats(dateFrom time.Time, dateTo time.Time) (*AccountEmailStats, error)
+	EmailStatsContext(ctx context.Context, dateFrom time.Time, dateTo time.Time) (*AccountEmailStats, error)
+}
+
+// SMTPService is implemented by SendpulseClient.SMTP
+type SMTPService interface {
+	Send(msg SMTPEmail) (*SMTPSendResult, error)
+	SendContext(ctx context.Context, msg SMTPEmail) (*SMTPSendResult, error)
+	SendByTemplate(templateID string, to []Recipient, variables map[string]interface{}, subject string, fromName string, fromEmail string) (*SMTPSendResult, error)
+	SendByTemplateContext(ctx context.Context, templateID string, to []Recipient, variables map[string]interface{}, subject string, fromName string, fromEmail string) (*SMTPSendResult, error)
+	List(params SMTPListParams) ([]SMTPEmailInfo, error)
+	ListContext(ctx context.Context, params SMTPListParams) ([]SMTPEmailInfo, error)
+	Get(emailID string) (*SMTPEmailInfo, error)
+	GetContext(ctx context.Context, emailID string) (*SMTPEmailInfo, error)
+	Unsubscribed(limit int, offset int) ([]SMTPUnsubscribe, error)
+	UnsubscribedContext(ctx context.Context, limit int, offset int) ([]SMTPUnsubscribe, error)
+	AddToUnsubscribe(entries []SMTPUnsubscribeEntry) error
+	AddToUnsubscribeContext(ctx context.Context, entries []SMTPUnsubscribeEntry) error
+	RemoveFromUnsubscribe(emails []string) error
+	RemoveFromUnsubscribeContext(ctx context.Context, emails []string) error
+	Webhooks() ([]Webhook, error)
+	WebhooksContext(ctx context.Context) ([]Webhook, error)
+	CreateWebhook(actions []string, webhookUrl string) ([]Webhook, error)
+	CreateWebhookContext(ctx context.Context, actions []string, webhookUrl string) ([]Webhook, error)
+	UpdateWebhook(webhookID int, webhookUrl string) error
+	UpdateWebhookContext(ctx context.Context, webhookID int, webhookUrl string) error
+	DeleteWebhook(webhookID int) error
+	DeleteWebhookContext(ctx context.Context, webhookID int) error
+	Bounces(dateFrom time.Time, dateTo time.Time, limit int, offset int) ([]BounceRecord, error)
+	BouncesContext(ctx context.Context, dateFrom time.Time, dateTo time.Time, limit int, offset int) ([]BounceRecord, error)
+	Complaints(dateFrom time.Time, dateTo time.Time, limit int, offset int) ([]ComplaintRecord, error)
+	ComplaintsContext(ctx context.Context, dateFrom time.Time, dateTo time.Time, limit int, offset int) ([]ComplaintRecord, error)
+	Domains() ([]SMTPDomain, error)
+	DomainsContext(ctx context.Context) ([]SMTPDomain, error)
+	AddDomain(domain string) error
+	AddDomainContext(ctx context.Context, domain string) error
+	VerifyDomain(domain string) (*DomainVerification, error)
+	VerifyDomainContext(ctx context.Context, domain string) (*DomainVerification, error)
+	AllowedIPs() ([]string, error)
+	AllowedIPsContext(ctx context.Context) ([]string, error)
+	AddAllowedIP(ip string) error
+	AddAllowedIPContext(ctx context.Context, ip string) error
+	RemoveAllowedIP(ip string) error
+	RemoveAllowedIPContext(ctx context.Context, ip string) error
+}
+
+// SMSService is implemented by SendpulseClient.SMS
+type SMSService interface {
+	SendByList(sender string, phones []string, body string, sendDate *time.Time) (*SMSResult, error)
+	SendByListContext(ctx context.Context, sender string, phones []string, body string, sendDate *time.Time) (*SMSResult, error)
+	SendByListIdempotent(sender string, phones []string, body string, sendDate *time.Time, idempotencyKey string) (*SMSResult, error)
+	SendByListIdempotentContext(ctx context.Context, sender string, phones []string, body string, sendDate *time.Time, idempotencyKey string) (*SMSResult, error)
+	SendByBook(sender string, addressBookID int, body string, sendDate *time.Time) (*SMSResult, error)
+	SendByBookContext(ctx context.Context, sender string, addressBookID int, body string, sendDate *time.Time) (*SMSResult, error)
+	SendByBookIdempotent(sender string, addressBookID int, body string, sendDate *time.Time, idempotencyKey string) (*SMSResult, error)
+	SendByBookIdempotentContext(ctx context.Context, sender string, addressBookID int, body string, sendDate *time.Time, idempotencyKey string) (*SMSResult, error)
+	Campaigns(dateFrom time.Time, dateTo time.Time) ([]SMSCampaign, error)
+	CampaignsContext(ctx context.Context, dateFrom time.Time, dateTo time.Time) ([]SMSCampaign, error)
+	CampaignInfo(campaignID int) (*SMSCampaignDetail, error)
+	CampaignInfoContext(ctx context.Context, campaignID int) (*SMSCampaignDetail, error)
+	CancelCampaign(campaignID int) error
+	CancelCampaignContext(ctx context.Context, campaignID int) error
+	AddPhones(addressBookID int, phones []string) error
+	AddPhonesContext(ctx context.Context, addressBookID int, phones []string) error
+	AddPhonesWithVariables(addressBookID int, phones []PhoneContact) error
+	AddPhonesWithVariablesContext(ctx context.Context, addressBookID int, phones []PhoneContact) error
+	DeletePhones(addressBookID int, phones []string) error
+	DeletePhonesContext(ctx context.Context, addressBookID int, phones []string) error
+	AddToBlacklist(phones []string, comment string) error
+	AddToBlacklistContext(ctx context.Context, phones []string, comment string) error
+	RemoveFromBlacklist(phones []string) error
+	RemoveFromBlacklistContext(ctx context.Context, phones []string) error
+	Blacklist() ([]string, error)
+	BlacklistContext(ctx context.Context) ([]string, error)
+	RecipientStatus(campaignID int, phone string) (*SMSDeliveryStatus, error)
+	RecipientStatusContext(ctx context.Context, campaignID int, phone string) (*SMSDeliveryStatus, error)
+	Senders() ([]SMSSender, error)
+	SendersContext(ctx context.Context) ([]SMSSender, error)
+	AddSender(name string) error
+	AddSenderContext(ctx context.Context, name string) error
+}
+
+// ViberService is implemented by SendpulseClient.Viber
+type ViberService interface {
+	SendCampaign(params ViberCampaignParams) (*ViberResult, error)
+	SendCampaignContext(ctx context.Context, params ViberCampaignParams) (*ViberResult, error)
+	Campaigns() ([]ViberCampaign, error)
+	CampaignsContext(ctx context.Context) ([]ViberCampaign, error)
+	CampaignInfo(campaignID int) (*ViberCampaignDetail, error)
+	CampaignInfoContext(ctx context.Context, campaignID int) (*ViberCampaignDetail, error)
+	CampaignStats(campaignID int) (*ViberStats, error)
+	CampaignStatsContext(ctx context.Context, campaignID int) (*ViberStats, error)
+	Senders() ([]ViberSender, error)
+	SendersContext(ctx context.Context) ([]ViberSender, error)
+	SenderInfo(senderID int) (*ViberSender, error)
+	SenderInfoContext(ctx context.Context, senderID int) (*ViberSender, error)
+	SendMessage(senderID int, phone string, message string, opts ...ViberMessageOption) (*ViberResult, error)
+	SendMessageContext(ctx context.Context, senderID int, phone string, message string, opts ...ViberMessageOption) (*ViberResult, error)
+}
+
+// PushService is implemented by SendpulseClient.Push
+type PushService interface {
+	Websites(limit int, offset int) ([]Website, error)
+	WebsitesContext(ctx context.Context, limit int, offset int) ([]Website, error)
+	WebsiteInfo(websiteID int) (*Website, error)
+	WebsiteInfoContext(ctx context.Context, websiteID int) (*Website, error)
+	CreateCampaign(params PushParams) (*PushResult, error)
+	CreateCampaignContext(ctx context.Context, params PushParams) (*PushResult, error)
+	Campaigns(limit int, offset int) ([]PushCampaign, error)
+	CampaignsContext(ctx context.Context, limit int, offset int) ([]PushCampaign, error)
+	Subscribers(websiteID int, limit int, offset int) ([]PushSubscriber, error)
+	SubscribersContext(ctx context.Context, websiteID int, limit int, offset int) ([]PushSubscriber, error)
+	IterateSubscribers(websiteID int, batchSize int, fn func(PushSubscriber) error) error
+	IterateSubscribersContext(ctx context.Context, websiteID int, batchSize int, fn func(PushSubscriber) error) error
+	UnsubscribeSubscriber(websiteID int, subscriberID int) error
+	UnsubscribeSubscriberContext(ctx context.Context, websiteID int, subscriberID int) error
+	WebsiteVariables(websiteID int) ([]PushVariable, error)
+	WebsiteVariablesContext(ctx context.Context, websiteID int) ([]PushVariable, error)
+	CampaignInfo(campaignID int) (*PushCampaign, error)
+	CampaignInfoContext(ctx context.Context, campaignID int) (*PushCampaign, error)
+	CampaignStats(campaignID int) (*PushStats, error)
+	CampaignStatsContext(ctx context.Context, campaignID int) (*PushStats, error)
+}
+
+// ChatbotService holds the methods shared by all chatbot channels
+type ChatbotService interface {
+	Bots() ([]Bot, error)
+	BotsContext(ctx context.Context) ([]Bot, error)
+	Contacts(botID string, limit int, offset int) ([]ChatbotContact, error)
+	ContactsContext(ctx context.Context, botID string, limit int, offset int) ([]ChatbotContact, error)
+	RunFlow(contactID string, flowID string, externalData map[string]interface{}) error
+	RunFlowContext(ctx context.Context, contactID string, flowID string, externalData map[string]interface{}) error
+	RunFlowByTrigger(contactID string, triggerKeyword string, externalData map[string]interface{}) error
+	RunFlowByTriggerContext(ctx context.Context, contactID string, triggerKeyword string, externalData map[string]interface{}) error
+	GetContact(contactID string) (*ChatbotContact, error)
+	GetContactContext(ctx context.Context, contactID string) (*ChatbotContact, error)
+	SetVariable(contactID string, name string, value interface{}) error
+	SetVariableContext(ctx context.Context, contactID string, name string, value interface{}) error
+	SetVariables(contactID string, variables map[string]interface{}) error
+	SetVariablesContext(ctx context.Context, contactID string, variables map[string]interface{}) error
+	PauseAutomation(contactID string, duration time.Duration) error
+	PauseAutomationContext(ctx context.Context, contactID string, duration time.Duration) error
+	ResumeAutomation(contactID string) error
+	ResumeAutomationContext(ctx context.Context, contactID string) error
+	UnsubscribeContactFromFlow(contactID string, flowID string) error
+	UnsubscribeContactFromFlowContext(ctx context.Context, contactID string, flowID string) error
+}
+
+// TelegramService is implemented by SendpulseClient.Telegram
+type TelegramService interface {
+	ChatbotService
+	SendMessage(contactID string, message TelegramMessage) error
+	SendMessageContext(ctx context.Context, contactID string, message TelegramMessage) error
+}
+
+// WhatsAppService is implemented by SendpulseClient.WhatsApp
+type WhatsAppService interface {
+	ChatbotService
+	SendText(contactID string, text string) error
+	SendTextContext(ctx context.Context, contactID string, text string) error
+	SendTemplate(contactID string, templateName string, language string, components []TemplateComponent) error
+	SendTemplateContext(ctx context.Context, contactID string, templateName string, language string, components []TemplateComponent) error
+	UploadMedia(botID string, filename string, data []byte) (string, error)
+	UploadMediaContext(ctx context.Context, botID string, filename string, data []byte) (string, error)
+	SendImage(contactID string, mediaID string, caption string) error
+	SendImageContext(ctx context.Context, contactID string, mediaID string, caption string) error
+	SendDocument(contactID string, mediaID string, filename string) error
+	SendDocumentContext(ctx context.Context, contactID string, mediaID string, filename string) error
+}
+
+// MessengerService is implemented by SendpulseClient.Messenger
+type MessengerService interface {
+	ChatbotService
+	SendMessage(contactID string, message MessengerMessage) error
+	SendMessageContext(ctx context.Context, contactID string, message MessengerMessage) error
+}
+
+// InstagramService is implemented by SendpulseClient.Instagram
+type InstagramService interface {
+	ChatbotService
+	SendMessage(contactID string, text string) error
+	SendMessageContext(ctx context.Context, contactID string, text string) error
+}
+
+var (
+	_ BooksService         = (*books)(nil)
+	_ Automation360Service = (*automation360)(nil)
+	_ CampaignsService     = (*campaigns)(nil)
+	_ BlacklistService     = (*blacklist)(nil)
+	_ SendersService       = (*senders)(nil)
+	_ TemplatesService     = (*templates)(nil)
+	_ VerifierService      = (*verifier)(nil)
+	_ BalanceService       = (*balance)(nil)
+	_ AccountService       = (*account)(nil)
+	_ SMTPService          = (*smtp)(nil)
+	_ SMSService           = (*sms)(nil)
+	_ ViberService         = (*viber)(nil)
+	_ PushService          = (*push)(nil)
+	_ ChatbotService       = (*chatbot)(nil)
+	_ TelegramService      = (*telegram)(nil)
+	_ WhatsAppService      = (*whatsapp)(nil)
+	_ MessengerService     = (*messenger)(nil)
+	_ InstagramService     = (*instagram)(nil)
+)
@@ -0,0 +1,47 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+type blacklistMock struct {
+	BlacklistService
+	added []string
+}
+
+func (m *blacklistMock) Add(emails []string, comment string) error {
+	m.added = append(m.added, emails...)
+	return nil
+}
+
+func blockEmail(service BlacklistService, email string) error {
+	return service.Add([]string{email}, "")
+}
+
+func TestInterfaces_Mock(t *testing.T) {
+	mock := &blacklistMock{}
+	assert.NoError(t, blockEmail(mock, "john@example.com"))
+	assert.Equal(t, []string{"john@example.com"}, mock.added)
+}
+
+func TestInterfaces_Client(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/blacklist",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	assert.NoError(t, blockEmail(&spClient.Emails.Blacklist, "john@example.com"))
+}
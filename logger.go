@@ -0,0 +1,86 @@
+package sendpulse
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Logger receives debug output of every request and response, with credentials redacted
+type Logger interface {
+	Logf(format string, args ...interface{})
+}
+
+const redacted = "[REDACTED]"
+
+var accessTokenPattern = regexp.MustCompile(`("access_token"\s*:\s*")[^"]*(")`)
+
+// redactedHeaders are the headers carrying credentials, their values are never logged
+var redactedHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"X-Api-Key":           true,
+}
+
+// isRedactedHeader reports whether the value of the header is hidden from the logger: the credential headers,
+// the ones with "token" or "secret" in the name and those of Config.RedactedHeaders
+func (c *client) isRedactedHeader(name string) bool {
+	name = http.CanonicalHeaderKey(name)
+	if redactedHeaders[name] {
+		return true
+	}
+	lower := strings.ToLower(name)
+	if strings.Contains(lower, "token") || strings.Contains(lower, "secret") {
+		return true
+	}
+	for _, extra := range c.config.RedactedHeaders {
+		if http.CanonicalHeaderKey(extra) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *client) logRequest(req *http.Request, body requestBody) {
+	headers := make([]string, 0, len(req.Header))
+	for name, values := range req.Header {
+		value := strings.Join(values, ", ")
+		if c.isRedactedHeader(name) {
+			value = redacted
+		}
+		headers = append(headers, name+": "+value)
+	}
+	sort.Strings(headers)
+
+	c.config.Logger.Logf("sendpulse: request %s %s headers: [%s] body: %s",
+		req.Method, req.URL.String(), strings.Join(headers, "; "), redactRequestBody(req.Method, body))
+}
+
+func (c *client) logResponse(method string, path string, statusCode int, body []byte) {
+	c.config.Logger.Logf("sendpulse: response %s %s status: %d body: %s",
+		method, c.config.BaseURL+path, statusCode, accessTokenPattern.ReplaceAllString(string(body), "${1}"+redacted+"${2}"))
+}
+
+func redactRequestBody(method string, body requestBody) string {
+	// Uploaded files are binary and may be large, so only their size is logged
+	if strings.HasPrefix(body.contentType, "multipart/form-data") {
+		return fmt.Sprintf("[multipart, %d bytes]", len(body.data))
+	}
+
+	if method == "GET" || !strings.HasPrefix(body.contentType, "application/x-www-form-urlencoded") {
+		return string(body.data)
+	}
+
+	values, err := url.ParseQuery(string(body.data))
+	if err != nil {
+		return string(body.data)
+	}
+	if values.Get("client_secret") != "" {
+		values.Set("client_secret", redacted)
+	}
+	return values.Encode()
+}
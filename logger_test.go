@@ -0,0 +1,98 @@
+package sendpulse
+
+import (
+	"context"
+	"fmt"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type testLogger struct {
+	lines []string
+}
+
+func (l *testLogger) Logf(format string, args ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, args...))
+}
+
+func TestClient_Logger(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	token := fake.CharactersN(20)
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusOK,
+			`{"access_token": "`+token+`","token_type": "Bearer","expires_in": 3600}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusOK, `[{"id": 1}]`))
+
+	secret := fake.CharactersN(20)
+	logger := &testLogger{}
+	config := Config{
+		UserID:  fake.CharactersN(10),
+		Secret:  secret,
+		Timeout: 5,
+		Logger:  logger,
+	}
+
+	c := NewClient(config)
+	_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", map[string]interface{}{"limit": 10}, true)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 4, len(logger.lines))
+	assert.True(t, strings.HasPrefix(logger.lines[0], "sendpulse: request POST "+apiBaseUrl+"/oauth/access_token"))
+	assert.Contains(t, logger.lines[0], "client_secret="+url.QueryEscape(redacted))
+	assert.Contains(t, logger.lines[1], "sendpulse: response POST "+apiBaseUrl+"/oauth/access_token status: 200")
+	assert.Contains(t, logger.lines[2], "sendpulse: request GET "+apiBaseUrl+"/addressbooks?limit=10")
+	assert.Contains(t, logger.lines[2], "Authorization: "+redacted)
+	assert.Equal(t, "sendpulse: response GET "+apiBaseUrl+"/addressbooks status: 200 body: [{\"id\": 1}]", logger.lines[3])
+
+	for _, line := range logger.lines {
+		assert.NotContains(t, line, secret)
+		assert.NotContains(t, line, token)
+	}
+}
+
+func TestClient_Logger_RedactedHeaders(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	logger := &testLogger{}
+	c := NewClient(Config{UserID: fake.CharactersN(10), Secret: fake.CharactersN(20), Timeout: 5},
+		WithLogger(logger),
+		WithDefaultHeaders(http.Header{
+			"X-Api-Key":     []string{"api-key-value"},
+			"X-Auth-Token":  []string{"token-value"},
+			"X-Signature":   []string{"signature-value"},
+			"X-Request-Id":  []string{"request-1"},
+			"Cookie":        []string{"session=cookie-value"},
+			"X-Partner-Key": []string{"partner-value"},
+		}),
+		WithRedactedHeaders("x-signature"),
+	)
+	c.token = "access-token-value"
+
+	_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(logger.lines))
+	for _, value := range []string{"api-key-value", "token-value", "signature-value", "cookie-value", c.token} {
+		assert.NotContains(t, logger.lines[0], value)
+	}
+	assert.Contains(t, logger.lines[0], "X-Api-Key: "+redacted)
+	assert.Contains(t, logger.lines[0], "X-Request-Id: request-1")
+	assert.Contains(t, logger.lines[0], "X-Partner-Key: partner-value")
+}
+
+func TestLogger_MultipartBody(t *testing.T) {
+	body := requestBody{"multipart/form-data; boundary=xyz", []byte("binary")}
+	assert.Equal(t, "[multipart, 6 bytes]", redactRequestBody("POST", body))
+}
@@ -0,0 +1,73 @@
+package sendpulse
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Observer receives the outcome of every request attempt, e.g. to export metrics.
+// The route is the request path with ids and other values replaced by placeholders, such as
+// "/addressbooks/{id}/emails/{value}", so it can be used as a low-cardinality label.
+// The status is 0 when no response was received; err is nil for 2xx responses
+type Observer interface {
+	ObserveRequest(method string, route string, status int, duration time.Duration, err error)
+}
+
+type routeContextKey struct{}
+
+// withRoute sets the route reported to the observer for paths with free-form segments that can't be recognized
+// by normalizeRoute, e.g. variable names
+func withRoute(ctx context.Context, route string) context.Context {
+	return context.WithValue(ctx, routeContextKey{}, route)
+}
+
+// requestRoute returns the route set by withRoute or, if there's none, the normalized path
+func requestRoute(ctx context.Context, path string) string {
+	if route, ok := ctx.Value(routeContextKey{}).(string); ok {
+		return route
+	}
+	return normalizeRoute(path)
+}
+
+// observe reports the attempt started at start to the observer, if there is one
+func (c *client) observe(method string, route string, start time.Time, status int, err error) {
+	if c.config.Observer == nil {
+		return
+	}
+	c.config.Observer.ObserveRequest(method, route, status, time.Since(start), err)
+}
+
+// normalizeRoute replaces numeric and long hexadecimal ids of the path by {id}, and emails, domains, IPs
+// and escaped values by {value}
+func normalizeRoute(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case segment == "":
+		case isDigits(segment) || (len(segment) >= 16 && isHex(segment)):
+			segments[i] = "{id}"
+		case strings.ContainsAny(segment, "@.:%+ "):
+			segments[i] = "{value}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isHex(s string) bool {
+	for _, r := range s {
+		if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'f' || r >= 'A' && r <= 'F') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+type observation struct {
+	method string
+	route  string
+	status int
+	err    error
+}
+
+type testObserver struct {
+	mu           sync.Mutex
+	observations []observation
+}
+
+func (o *testObserver) ObserveRequest(method string, route string, status int, duration time.Duration, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.observations = append(o.observations, observation{method: method, route: route, status: status, err: err})
+}
+
+func TestClient_Observer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusOK, `{"access_token": "testtoken","token_type": "Bearer","expires_in": 3600}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks/12",
+		httpmock.NewStringResponder(http.StatusOK, `[{"id": 12, "name": "Clients"}]`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks/13",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"error_code": 400, "message": "Invalid book"}`))
+
+	observer := &testObserver{}
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5}, WithObserver(observer))
+
+	_, err := spClient.Emails.Books.Get(12)
+	assert.NoError(t, err)
+	_, err = spClient.Emails.Books.Get(13)
+	assert.Error(t, err)
+
+	assert.Equal(t, 3, len(observer.observations))
+	assert.Equal(t, observation{method: "POST", route: "/oauth/access_token", status: http.StatusOK}, observer.observations[0])
+	assert.Equal(t, observation{method: "GET", route: "/addressbooks/{id}", status: http.StatusOK}, observer.observations[1])
+
+	failed := observer.observations[2]
+	assert.Equal(t, "/addressbooks/{id}", failed.route)
+	assert.Equal(t, http.StatusBadRequest, failed.status)
+	assert.Equal(t, err, failed.err)
+}
+
+func TestClient_ObserverRetries(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	calls := 0
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks/12/emails/john@example.com",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 1 {
+				return httpmock.NewStringResponse(http.StatusServiceUnavailable, ``), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"email": "john@example.com", "status": 1}`), nil
+		})
+
+	observer := &testObserver{}
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5},
+		WithObserver(observer), WithRetry(2, time.Millisecond, time.Millisecond))
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Emails.Books.EmailInfo(12, "john@example.com")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(observer.observations))
+	assert.Equal(t, "/addressbooks/{id}/emails/{value}", observer.observations[0].route)
+	assert.Equal(t, http.StatusServiceUnavailable, observer.observations[0].status)
+	assert.Error(t, observer.observations[0].err)
+	assert.Equal(t, observation{method: "GET", route: "/addressbooks/{id}/emails/{value}", status: http.StatusOK}, observer.observations[1])
+}
+
+func TestNormalizeRoute(t *testing.T) {
+	for path, route := range map[string]string{
+		"/addressbooks": "/addressbooks",
+		"/addressbooks/12/emails/john%40mail.com":     "/addressbooks/{id}/emails/{value}",
+		"/addressbooks/12/emails/john+news@mail.com":  "/addressbooks/{id}/emails/{value}",
+		"/smtp/domains/example.com/verify":            "/smtp/domains/{value}/verify",
+		"/telegram/contacts/60c1a2b3c4d5e6f708192a3b": "/telegram/contacts/{id}",
+		"/a360/autoresponders/101/start":              "/a360/autoresponders/{id}/start",
+	} {
+		assert.Equal(t, route, normalizeRoute(path))
+	}
+}
@@ -0,0 +1,90 @@
+package sendpulse
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option changes the client configuration. Options are applied over Config in the order they're passed
+type Option func(*Config)
+
+// WithHTTPClient sets the client used for all requests, Config.Timeout is ignored then
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(config *Config) {
+		config.HTTPClient = httpClient
+	}
+}
+
+// WithBaseURL overrides the SendPulse API address
+func WithBaseURL(baseURL string) Option {
+	return func(config *Config) {
+		config.BaseURL = baseURL
+	}
+}
+
+// WithUserAgent overrides the default User-Agent header
+func WithUserAgent(userAgent string) Option {
+	return func(config *Config) {
+		config.UserAgent = userAgent
+	}
+}
+
+// WithLogger sets the logger receiving every request and response
+func WithLogger(logger Logger) Option {
+	return func(config *Config) {
+		config.Logger = logger
+	}
+}
+
+// WithRedactedHeaders hides the values of more headers from the logger, e.g. the custom ones carrying credentials
+func WithRedactedHeaders(names ...string) Option {
+	return func(config *Config) {
+		config.RedactedHeaders = append(config.RedactedHeaders, names...)
+	}
+}
+
+// WithRetry sets how many times a request is sent and the delays between attempts. Zero values keep the defaults
+func WithRetry(maxAttempts int, backoffBase time.Duration, backoffMax time.Duration) Option {
+	return func(config *Config) {
+		config.MaxAttempts = maxAttempts
+		config.RetryBackoffBase = backoffBase
+		config.RetryBackoffMax = backoffMax
+	}
+}
+
+// WithTokenExpiryBuffer sets how long before its expiry the access token is refreshed. Zero keeps the default
+func WithTokenExpiryBuffer(buffer time.Duration) Option {
+	return func(config *Config) {
+		config.TokenExpiryBuffer = buffer
+	}
+}
+
+// WithTokenStore sets the store sharing the access token between clients and processes
+func WithTokenStore(store TokenStore) Option {
+	return func(config *Config) {
+		config.TokenStore = store
+	}
+}
+
+// WithDefaultHeaders sets headers added to every request, e.g. X-Request-ID.
+// Authorization, Content-Type and User-Agent are set by the client and can't be overridden
+func WithDefaultHeaders(header http.Header) Option {
+	return func(config *Config) {
+		config.DefaultHeaders = header.Clone()
+	}
+}
+
+// WithObserver sets the observer receiving the outcome of every request attempt, e.g. to export metrics
+func WithObserver(observer Observer) Option {
+	return func(config *Config) {
+		config.Observer = observer
+	}
+}
+
+// WithoutAutoReauth makes a 401 response be returned as *SendpulseError right away, without refreshing the token
+// and repeating the request
+func WithoutAutoReauth() Option {
+	return func(config *Config) {
+		config.DisableAutoReauth = true
+	}
+}
@@ -0,0 +1,61 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewClient_Options(t *testing.T) {
+	httpClient := &http.Client{}
+	store := &testTokenStore{}
+	logger := &testLogger{}
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word()},
+		WithHTTPClient(httpClient),
+		WithBaseURL("https://sendpulse.example.com/"),
+		WithUserAgent("my-app/1.0"),
+		WithLogger(logger),
+		WithRetry(5, time.Second, 10*time.Second),
+		WithTokenStore(store),
+		WithTokenExpiryBuffer(5*time.Minute),
+	)
+
+	assert.True(t, httpClient == c.httpClient)
+	assert.Equal(t, "https://sendpulse.example.com", c.config.BaseURL)
+	assert.Equal(t, "my-app/1.0", c.config.UserAgent)
+	assert.Equal(t, logger, c.config.Logger)
+	assert.Equal(t, 5, c.config.MaxAttempts)
+	assert.Equal(t, time.Second, c.config.RetryBackoffBase)
+	assert.Equal(t, 10*time.Second, c.config.RetryBackoffMax)
+	assert.Equal(t, store, c.config.TokenStore)
+	assert.Equal(t, 5*time.Minute, c.config.TokenExpiryBuffer)
+}
+
+func TestNewClient_OptionsOverrideConfig(t *testing.T) {
+	c := NewClient(Config{UserAgent: "from-config", MaxAttempts: 2}, WithUserAgent("from-option"), WithRetry(0, 0, 0))
+
+	assert.Equal(t, "from-option", c.config.UserAgent)
+	assert.Equal(t, defaultMaxAttempts, c.config.MaxAttempts)
+	assert.Equal(t, defaultRetryBackoffBase, c.config.RetryBackoffBase)
+
+	c = NewClient(Config{TokenExpiryBuffer: time.Minute}, WithTokenExpiryBuffer(0))
+	assert.Equal(t, defaultTokenExpiryBuffer, c.config.TokenExpiryBuffer)
+}
+
+func TestApiClient_WithBaseURL(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://sendpulse.example.com/balance",
+		httpmock.NewStringResponder(http.StatusOK, `{"currency": "USD", "balance_currency": 10}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.Word(), Secret: fake.Word()}, WithBaseURL("https://sendpulse.example.com"))
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Balance.Get("")
+	assert.NoError(t, err)
+}
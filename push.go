@@ -0,0 +1,517 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type push struct {
+	Client *client
+}
+
+// PushStatus is the state of a push campaign as reported by SendPulse
+type PushStatus int
+
+const (
+	PushStatusNew        PushStatus = 0
+	PushStatusSending    PushStatus = 1
+	PushStatusSent       PushStatus = 2
+	PushStatusError      PushStatus = 3
+	PushStatusModeration PushStatus = 4
+)
+
+func (s PushStatus) String() string {
+	switch s {
+	case PushStatusNew:
+		return "new"
+	case PushStatusSending:
+		return "sending"
+	case PushStatusSent:
+		return "sent"
+	case PushStatusError:
+		return "error"
+	case PushStatusModeration:
+		return "moderation"
+	}
+	return fmt.Sprintf("unknown (%d)", int(s))
+}
+
+type Website struct {
+	ID                int
+	Url               string
+	Status            int
+	Icon              string
+	TotalSubscribers  int
+	ActiveSubscribers int
+	Unsubscribed      int
+	SubscribersToday  int
+	AddDate           time.Time
+}
+
+type websiteRaw struct {
+	ID                interface{} `json:"id"`
+	Url               string      `json:"url"`
+	Status            interface{} `json:"status"`
+	Icon              string      `json:"icon"`
+	TotalSubscribers  interface{} `json:"total_subscribers"`
+	ActiveSubscribers interface{} `json:"active_subscribers"`
+	Unsubscribed      interface{} `json:"unsubscribed"`
+	SubscribersToday  interface{} `json:"subscribers_today"`
+	AddDate           string      `json:"add_date"`
+}
+
+func (raw websiteRaw) website() Website {
+	website := Website{
+		ID:                toInt(raw.ID),
+		Url:               raw.Url,
+		Status:            toInt(raw.Status),
+		Icon:              raw.Icon,
+		TotalSubscribers:  toInt(raw.TotalSubscribers),
+		ActiveSubscribers: toInt(raw.ActiveSubscribers),
+		Unsubscribed:      toInt(raw.Unsubscribed),
+		SubscribersToday:  toInt(raw.SubscribersToday),
+	}
+	if addDate, err := time.Parse(sendDateLayout, raw.AddDate); err == nil {
+		website.AddDate = addDate
+	}
+	return website
+}
+
+// PushFilterCondition is a single condition on a subscriber variable, e.g. {Condition: "likewith", Value: "Kyiv"}
+type PushFilterCondition struct {
+	Condition string `json:"condition"`
+	Value     string `json:"value"`
+}
+
+// PushFilter limits the recipients of a push campaign by the value of a subscriber variable.
+// Operator combines the conditions and is either "or" or "and"
+type PushFilter struct {
+	VariableName string                `json:"variable_name"`
+	Operator     string                `json:"operator"`
+	Conditions   []PushFilterCondition `json:"conditions"`
+}
+
+// PushParams describes a push campaign. TTL (in seconds), Link and Filter are optional
+type PushParams struct {
+	Title     string
+	Body      string
+	WebsiteID int
+	TTL       int
+	Link      string
+	Filter    *PushFilter
+	// SendDate schedules the campaign; it's sent immediately when zero
+	SendDate time.Time
+}
+
+type pushParamsRaw struct {
+	Title     string      `json:"title"`
+	Body      string      `json:"body"`
+	WebsiteID int         `json:"website_id"`
+	TTL       int         `json:"ttl,omitempty"`
+	Link      string      `json:"link,omitempty"`
+	Filter    *PushFilter `json:"filter,omitempty"`
+	SendDate  string      `json:"send_date,omitempty"`
+}
+
+type PushResult struct {
+	ID int
+}
+
+type PushCampaign struct {
+	ID        int
+	Title     string
+	Body      string
+	Link      string
+	WebsiteID int
+	Website   string
+	Status    PushStatus
+	SendDate  time.Time
+}
+
+type pushCampaignRaw struct {
+	ID        interface{} `json:"id"`
+	Title     string      `json:"title"`
+	Body      string      `json:"body"`
+	Link      string      `json:"link"`
+	WebsiteID interface{} `json:"website_id"`
+	Website   string      `json:"website"`
+	Status    interface{} `json:"status"`
+	SendDate  string      `json:"send_date"`
+	Send      interface{} `json:"send"`
+	Delivered interface{} `json:"delivered"`
+	Failed    interface{} `json:"failed"`
+	Redirect  interface{} `json:"redirect"`
+	Message   *struct {
+		Title string `json:"title"`
+		Text  string `json:"text"`
+		Link  string `json:"link"`
+	} `json:"message"`
+}
+
+func (raw pushCampaignRaw) campaign() PushCampaign {
+	campaign := PushCampaign{
+		ID:        toInt(raw.ID),
+		Title:     raw.Title,
+		Body:      raw.Body,
+		Link:      raw.Link,
+		WebsiteID: toInt(raw.WebsiteID),
+		Website:   raw.Website,
+		Status:    PushStatus(toInt(raw.Status)),
+	}
+	if raw.Message != nil {
+		campaign.Title = raw.Message.Title
+		campaign.Body = raw.Message.Text
+		campaign.Link = raw.Message.Link
+	}
+	if sendDate, err := time.Parse(sendDateLayout, raw.SendDate); err == nil {
+		campaign.SendDate = sendDate
+	}
+	return campaign
+}
+
+func (s *push) Websites(limit int, offset int) ([]Website, error) {
+	return s.WebsitesContext(context.Background(), limit, offset)
+}
+
+func (s *push) WebsitesContext(ctx context.Context, limit int, offset int) ([]Website, error) {
+	path := "/push/websites"
+	data := map[string]interface{}{
+		"limit":  fmt.Sprint(limit),
+		"offset": fmt.Sprint(offset),
+	}
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	websites := make([]Website, 0)
+	if isEmptyCollection(body) {
+		return websites, nil
+	}
+
+	var respData []websiteRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range respData {
+		websites = append(websites, raw.website())
+	}
+	return websites, nil
+}
+
+func (s *push) WebsiteInfo(websiteID int) (*Website, error) {
+	return s.WebsiteInfoContext(context.Background(), websiteID)
+}
+
+func (s *push) WebsiteInfoContext(ctx context.Context, websiteID int) (*Website, error) {
+	path := fmt.Sprintf("/push/websites/info/%d", websiteID)
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw websiteRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	website := raw.website()
+	return &website, nil
+}
+
+func (s *push) CreateCampaign(params PushParams) (*PushResult, error) {
+	return s.CreateCampaignContext(context.Background(), params)
+}
+
+func (s *push) CreateCampaignContext(ctx context.Context, params PushParams) (*PushResult, error) {
+	path := "/push/tasks"
+
+	payload := pushParamsRaw{
+		Title:     params.Title,
+		Body:      params.Body,
+		WebsiteID: params.WebsiteID,
+		TTL:       params.TTL,
+		Link:      params.Link,
+		Filter:    params.Filter,
+	}
+	if !params.SendDate.IsZero() {
+		payload.SendDate = formatSendDate(params.SendDate)
+	}
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "POST", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Client.checkResult("POST", path, body); err != nil {
+		return nil, err
+	}
+
+	var respData struct {
+		ID interface{} `json:"id"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("POST", path, body, err.Error())
+	}
+
+	return &PushResult{ID: toInt(respData.ID)}, nil
+}
+
+func (s *push) Campaigns(limit int, offset int) ([]PushCampaign, error) {
+	return s.CampaignsContext(context.Background(), limit, offset)
+}
+
+func (s *push) CampaignsContext(ctx context.Context, limit int, offset int) ([]PushCampaign, error) {
+	path := "/push/tasks"
+	data := map[string]interface{}{
+		"limit":  fmt.Sprint(limit),
+		"offset": fmt.Sprint(offset),
+	}
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	campaigns := make([]PushCampaign, 0)
+	if isEmptyCollection(body) {
+		return campaigns, nil
+	}
+
+	var respData []pushCampaignRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range respData {
+		campaigns = append(campaigns, raw.campaign())
+	}
+	return campaigns, nil
+}
+
+type PushSubscriber struct {
+	ID               int
+	Browser          string
+	Lang             string
+	OS               string
+	Country          string
+	City             string
+	Status           int
+	Variables        []Variable
+	SubscriptionDate time.Time
+}
+
+type pushSubscriberRaw struct {
+	ID               interface{} `json:"id"`
+	Browser          string      `json:"browser"`
+	Lang             string      `json:"lang"`
+	OS               string      `json:"os"`
+	Country          string      `json:"country"`
+	City             string      `json:"city"`
+	Status           interface{} `json:"status"`
+	Variables        []Variable  `json:"variables"`
+	SubscriptionDate string      `json:"subscription_date"`
+}
+
+func (raw pushSubscriberRaw) subscriber() PushSubscriber {
+	subscriber := PushSubscriber{
+		ID:        toInt(raw.ID),
+		Browser:   raw.Browser,
+		Lang:      raw.Lang,
+		OS:        raw.OS,
+		Country:   raw.Country,
+		City:      raw.City,
+		Status:    toInt(raw.Status),
+		Variables: raw.Variables,
+	}
+	if subscriptionDate, err := time.Parse(sendDateLayout, raw.SubscriptionDate); err == nil {
+		subscriber.SubscriptionDate = subscriptionDate
+	}
+	return subscriber
+}
+
+func (s *push) Subscribers(websiteID int, limit int, offset int) ([]PushSubscriber, error) {
+	return s.SubscribersContext(context.Background(), websiteID, limit, offset)
+}
+
+func (s *push) SubscribersContext(ctx context.Context, websiteID int, limit int, offset int) ([]PushSubscriber, error) {
+	path := fmt.Sprintf("/push/websites/%d/subscriptions", websiteID)
+	data := map[string]interface{}{
+		"limit":  fmt.Sprint(limit),
+		"offset": fmt.Sprint(offset),
+	}
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	subscribers := make([]PushSubscriber, 0)
+	if isEmptyCollection(body) {
+		return subscribers, nil
+	}
+
+	var respData []pushSubscriberRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range respData {
+		subscribers = append(subscribers, raw.subscriber())
+	}
+	return subscribers, nil
+}
+
+// IterateSubscribers calls fn for every subscriber of the website requesting them by batchSize per call.
+// Iteration stops at the first error returned by fn
+func (s *push) IterateSubscribers(websiteID int, batchSize int, fn func(PushSubscriber) error) error {
+	return s.IterateSubscribersContext(context.Background(), websiteID, batchSize, fn)
+}
+
+func (s *push) IterateSubscribersContext(ctx context.Context, websiteID int, batchSize int, fn func(PushSubscriber) error) error {
+	if batchSize <= 0 {
+		return errors.New("batch size must be positive")
+	}
+
+	for offset := 0; ; offset += batchSize {
+		subscribers, err := s.SubscribersContext(ctx, websiteID, batchSize, offset)
+		if err != nil {
+			return err
+		}
+
+		for _, subscriber := range subscribers {
+			if err := fn(subscriber); err != nil {
+				return err
+			}
+		}
+
+		if len(subscribers) < batchSize {
+			return nil
+		}
+	}
+}
+
+// UnsubscribeSubscriber deactivates the push subscription of the website.
+// SendPulse identifies subscriptions by their own ids, so websiteID isn't sent to the API
+func (s *push) UnsubscribeSubscriber(websiteID int, subscriberID int) error {
+	return s.UnsubscribeSubscriberContext(context.Background(), websiteID, subscriberID)
+}
+
+func (s *push) UnsubscribeSubscriberContext(ctx context.Context, websiteID int, subscriberID int) error {
+	path := "/push/websites/subscriptions/state"
+	data := map[string]interface{}{
+		"id":    fmt.Sprint(subscriberID),
+		"state": "0",
+	}
+
+	body, err := s.Client.makeRequest(ctx, path, "POST", data, true)
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("POST", path, body)
+}
+
+type PushVariable struct {
+	ID   int
+	Name string
+	Type string
+}
+
+type pushVariableRaw struct {
+	ID   interface{} `json:"id"`
+	Name string      `json:"name"`
+	Type string      `json:"type"`
+}
+
+func (s *push) WebsiteVariables(websiteID int) ([]PushVariable, error) {
+	return s.WebsiteVariablesContext(context.Background(), websiteID)
+}
+
+func (s *push) WebsiteVariablesContext(ctx context.Context, websiteID int) ([]PushVariable, error) {
+	path := fmt.Sprintf("/push/websites/%d/variables", websiteID)
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := make([]PushVariable, 0)
+	if isEmptyCollection(body) {
+		return variables, nil
+	}
+
+	var respData []pushVariableRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range respData {
+		variables = append(variables, PushVariable{ID: toInt(raw.ID), Name: raw.Name, Type: raw.Type})
+	}
+	return variables, nil
+}
+
+// PushStats is the delivery statistics of a push campaign
+type PushStats struct {
+	Sent      int
+	Delivered int
+	Failed    int
+	Clicked   int
+	Status    PushStatus
+}
+
+func (s *push) CampaignInfo(campaignID int) (*PushCampaign, error) {
+	return s.CampaignInfoContext(context.Background(), campaignID)
+}
+
+func (s *push) CampaignInfoContext(ctx context.Context, campaignID int) (*PushCampaign, error) {
+	raw, err := s.campaignRaw(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	campaign := raw.campaign()
+	return &campaign, nil
+}
+
+// CampaignStats returns the delivery statistics of the campaign. Counts sent by SendPulse as strings are parsed too
+func (s *push) CampaignStats(campaignID int) (*PushStats, error) {
+	return s.CampaignStatsContext(context.Background(), campaignID)
+}
+
+func (s *push) CampaignStatsContext(ctx context.Context, campaignID int) (*PushStats, error) {
+	raw, err := s.campaignRaw(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PushStats{
+		Sent:      toInt(raw.Send),
+		Delivered: toInt(raw.Delivered),
+		Failed:    toInt(raw.Failed),
+		Clicked:   toInt(raw.Redirect),
+		Status:    PushStatus(toInt(raw.Status)),
+	}, nil
+}
+
+func (s *push) campaignRaw(ctx context.Context, campaignID int) (*pushCampaignRaw, error) {
+	path := fmt.Sprintf("/push/tasks/%d", campaignID)
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw pushCampaignRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+	return &raw, nil
+}
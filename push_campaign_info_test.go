@@ -0,0 +1,60 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestPush_CampaignInfo_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/push/tasks/4451",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"id": 4451, "website": "example.com", "website_id": 53, "status": 2,
+			"message": {"title": "Flash sale", "text": "Only today -30%", "link": "https://example.com/sale"},
+			"send": "100", "delivered": "98", "redirect": "17"
+		}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	campaign, err := spClient.Push.CampaignInfo(4451)
+	assert.NoError(t, err)
+	assert.Equal(t, 4451, campaign.ID)
+	assert.Equal(t, "Flash sale", campaign.Title)
+	assert.Equal(t, "Only today -30%", campaign.Body)
+	assert.Equal(t, "https://example.com/sale", campaign.Link)
+	assert.Equal(t, "example.com", campaign.Website)
+	assert.Equal(t, PushStatusSent, campaign.Status)
+}
+
+func TestPush_CampaignInfo_NotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/push/tasks/1",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"error_code": 404, "message": "Not found"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Push.CampaignInfo(1)
+	assert.Error(t, err)
+	spErr, isSpErr := err.(*SendpulseError)
+	assert.True(t, isSpErr)
+	assert.Equal(t, http.StatusNotFound, spErr.HttpCode)
+}
@@ -0,0 +1,55 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestPush_CampaignStats_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/push/tasks/4451",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"id": 4451, "website_id": 53, "status": 2,
+			"send": 100, "delivered": 100, "failed": 0, "redirect": 17
+		}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	stats, err := spClient.Push.CampaignStats(4451)
+	assert.NoError(t, err)
+	assert.Equal(t, PushStats{Sent: 100, Delivered: 100, Failed: 0, Clicked: 17, Status: PushStatusSent}, *stats)
+}
+
+func TestPush_CampaignStats_PartiallyFailed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/push/tasks/4452",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"id": "4452", "website_id": "53", "status": "3",
+			"send": "120", "delivered": "95", "failed": "25", "redirect": "4"
+		}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	stats, err := spClient.Push.CampaignStats(4452)
+	assert.NoError(t, err)
+	assert.Equal(t, PushStats{Sent: 120, Delivered: 95, Failed: 25, Clicked: 4, Status: PushStatusError}, *stats)
+}
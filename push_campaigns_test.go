@@ -0,0 +1,35 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestPush_Campaigns_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/push/tasks?limit=10&offset=0",
+		httpmock.NewStringResponder(http.StatusOK, `[
+			{"id": 4451, "title": "Flash sale", "body": "Only today -30%", "website_id": 53, "status": 2, "send_date": "2021-05-01 12:00:00"}
+		]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	campaigns, err := spClient.Push.Campaigns(10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, campaigns, 1)
+	assert.Equal(t, 4451, campaigns[0].ID)
+	assert.Equal(t, 53, campaigns[0].WebsiteID)
+	assert.Equal(t, PushStatusSent, campaigns[0].Status)
+	assert.Equal(t, "sent", campaigns[0].Status.String())
+}
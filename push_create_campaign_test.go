@@ -0,0 +1,130 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPush_CreateCampaign_LinkAndTTL(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/push/tasks",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "id": 4451}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.Push.CreateCampaign(PushParams{
+		Title:     "Flash sale",
+		Body:      "Only today -30%",
+		WebsiteID: 53,
+		TTL:       3600,
+		Link:      "https://example.com/sale",
+		Filter: &PushFilter{
+			VariableName: "city",
+			Operator:     "or",
+			Conditions:   []PushFilterCondition{{Condition: "likewith", Value: "Kyiv"}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 4451, result.ID)
+	assert.JSONEq(t, `{
+		"title": "Flash sale",
+		"body": "Only today -30%",
+		"website_id": 53,
+		"ttl": 3600,
+		"link": "https://example.com/sale",
+		"filter": {"variable_name": "city", "operator": "or", "conditions": [{"condition": "likewith", "value": "Kyiv"}]}
+	}`, requestBody)
+}
+
+func TestPush_CreateCampaign_Minimal(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/push/tasks",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "id": 4452}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Push.CreateCampaign(PushParams{Title: "Hi", Body: "Hello", WebsiteID: 53})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"title": "Hi", "body": "Hello", "website_id": 53}`, requestBody)
+}
+
+func TestPush_CreateCampaign_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/push/tasks",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": false}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Push.CreateCampaign(PushParams{Title: "Hi", Body: "Hello", WebsiteID: 53})
+	assert.Error(t, err)
+}
+
+func TestPush_CreateCampaign_SendDate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/push/tasks",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "id": 4452}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	location := time.FixedZone("UTC+3", 3*60*60)
+	_, err := spClient.Push.CreateCampaign(PushParams{
+		Title:     "Flash sale",
+		Body:      "Only today -30%",
+		WebsiteID: 53,
+		SendDate:  time.Date(2030, 1, 2, 10, 30, 0, 0, location),
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"title": "Flash sale",
+		"body": "Only today -30%",
+		"website_id": 53,
+		"send_date": "2030-01-02 07:30:00"
+	}`, requestBody)
+}
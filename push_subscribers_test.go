@@ -0,0 +1,139 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPush_Subscribers_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/push/websites/53/subscriptions?limit=10&offset=0",
+		httpmock.NewStringResponder(http.StatusOK, `[{
+			"id": 901, "browser": "Chrome", "lang": "en", "os": "Windows", "country": "Ukraine", "city": "Kyiv",
+			"variables": [{"name": "plan", "type": "string", "value": "pro"}],
+			"subscription_date": "2021-02-03 04:05:06", "status": 1
+		}]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	subscribers, err := spClient.Push.Subscribers(53, 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []PushSubscriber{{
+		ID:               901,
+		Browser:          "Chrome",
+		Lang:             "en",
+		OS:               "Windows",
+		Country:          "Ukraine",
+		City:             "Kyiv",
+		Status:           1,
+		Variables:        []Variable{{Name: "plan", Type: "string", Value: "pro"}},
+		SubscriptionDate: time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC),
+	}}, subscribers)
+}
+
+func TestPush_Subscribers_Empty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/push/websites/53/subscriptions?limit=10&offset=0",
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	subscribers, err := spClient.Push.Subscribers(53, 10, 0)
+	assert.NoError(t, err)
+	assert.NotNil(t, subscribers)
+	assert.Len(t, subscribers, 0)
+}
+
+func TestPush_IterateSubscribers_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/push/websites/53/subscriptions?limit=2&offset=0",
+		httpmock.NewStringResponder(http.StatusOK, `[{"id": 1}, {"id": 2}]`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/push/websites/53/subscriptions?limit=2&offset=2",
+		httpmock.NewStringResponder(http.StatusOK, `[{"id": 3}]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	var ids []int
+	err := spClient.Push.IterateSubscribers(53, 2, func(subscriber PushSubscriber) error {
+		ids = append(ids, subscriber.ID)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, ids)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestPush_UnsubscribeSubscriber_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var id, state string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/push/websites/subscriptions/state",
+		func(req *http.Request) (*http.Response, error) {
+			req.ParseForm()
+			id = req.PostForm.Get("id")
+			state = req.PostForm.Get("state")
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Push.UnsubscribeSubscriber(53, 901)
+	assert.NoError(t, err)
+	assert.Equal(t, "901", id)
+	assert.Equal(t, "0", state)
+}
+
+func TestPush_WebsiteVariables_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/push/websites/53/variables",
+		httpmock.NewStringResponder(http.StatusOK, `[{"id": "7", "name": "plan", "type": "string"}]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	variables, err := spClient.Push.WebsiteVariables(53)
+	assert.NoError(t, err)
+	assert.Equal(t, []PushVariable{{ID: 7, Name: "plan", Type: "string"}}, variables)
+}
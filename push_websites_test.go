@@ -0,0 +1,85 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPush_Websites_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/push/websites?limit=10&offset=0",
+		httpmock.NewStringResponder(http.StatusOK, `[
+			{"id": 53, "url": "example.com", "add_date": "2021-03-01 10:00:00", "status": "1"},
+			{"id": 54, "url": "shop.example.com", "add_date": "2021-04-01 10:00:00", "status": 0}
+		]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	websites, err := spClient.Push.Websites(10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, websites, 2)
+	assert.Equal(t, 53, websites[0].ID)
+	assert.Equal(t, "example.com", websites[0].Url)
+	assert.Equal(t, 1, websites[0].Status)
+	assert.Equal(t, time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC), websites[0].AddDate)
+}
+
+func TestPush_Websites_Empty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/push/websites?limit=10&offset=0",
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	websites, err := spClient.Push.Websites(10, 0)
+	assert.NoError(t, err)
+	assert.NotNil(t, websites)
+	assert.Len(t, websites, 0)
+}
+
+func TestPush_WebsiteInfo_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/push/websites/info/53",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"id": 53, "url": "example.com", "status": 1, "icon": "https://example.com/icon.png",
+			"add_date": "2021-03-01 10:00:00", "total_subscribers": 120, "unsubscribed": "4",
+			"subscribers_today": 2, "active_subscribers": 116
+		}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	website, err := spClient.Push.WebsiteInfo(53)
+	assert.NoError(t, err)
+	assert.Equal(t, 120, website.TotalSubscribers)
+	assert.Equal(t, 116, website.ActiveSubscribers)
+	assert.Equal(t, 4, website.Unsubscribed)
+	assert.Equal(t, "https://example.com/icon.png", website.Icon)
+}
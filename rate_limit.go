@@ -0,0 +1,53 @@
+package sendpulse
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimiter throttles requests on the client side to stay under the SendPulse quota.
+// It's shared by all goroutines using the client, so it must be safe for concurrent use
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// defaultRetryAfter is the wait reported for a 429 response without a valid Retry-After header
+const defaultRetryAfter = time.Second
+
+// RetryAfter returns how long to wait before retrying when err is a rate limited (429) request,
+// for callers handling rate limiting themselves instead of Config.RetryRateLimited
+func RetryAfter(err error) (time.Duration, bool) {
+	var spErr *SendpulseError
+	if !errors.As(err, &spErr) || !spErr.IsRateLimited() {
+		return 0, false
+	}
+	return spErr.RetryAfter, true
+}
+
+// parseRetryAfter reads the Retry-After header value given either in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			seconds = 0
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if wait := date.Sub(now); wait > 0 {
+		return wait, true
+	}
+	return 0, true
+}
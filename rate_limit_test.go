@@ -0,0 +1,149 @@
+package sendpulse
+
+import (
+	"context"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testRateLimiter struct {
+	lock  sync.Mutex
+	calls int
+}
+
+func (l *testRateLimiter) Wait(ctx context.Context) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.calls++
+	return ctx.Err()
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	wait, ok := parseRetryAfter("7", now)
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Second, wait)
+
+	wait, ok = parseRetryAfter(now.Add(90*time.Second).Format(http.TimeFormat), now)
+	assert.True(t, ok)
+	assert.Equal(t, 90*time.Second, wait)
+
+	wait, ok = parseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+
+	_, ok = parseRetryAfter("", now)
+	assert.False(t, ok)
+
+	_, ok = parseRetryAfter("soon", now)
+	assert.False(t, ok)
+}
+
+func TestClient_MakeRequest_RateLimitedRetry(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	calls := 0
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 1 {
+				resp := httpmock.NewStringResponse(http.StatusTooManyRequests, `Too many requests`)
+				resp.Header.Set("Retry-After", "0")
+				return resp, nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `[]`), nil
+		})
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5, RetryRateLimited: true})
+	c.token = fake.Word()
+
+	body, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(body))
+	assert.Equal(t, 2, calls)
+}
+
+func TestClient_MakeRequest_RateLimitedNoRetry(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusTooManyRequests, `Too many requests`))
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5})
+	c.token = fake.Word()
+
+	_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	spErr, isSpError := err.(*SendpulseError)
+	assert.True(t, isSpError)
+	assert.True(t, spErr.IsRateLimited())
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestClient_MakeRequest_RateLimiter(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusOK,
+			`{"access_token": "testtoken","token_type": "Bearer","expires_in": 3600}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	limiter := &testRateLimiter{}
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5, RateLimiter: limiter})
+
+	for i := 0; i < 3; i++ {
+		_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 4, limiter.calls)
+}
+
+func TestRetryAfter(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	c := NewClient(Config{UserID: fake.Word(), Secret: fake.Word(), Timeout: 5})
+	c.token = fake.Word()
+
+	rateLimited := func(header string) error {
+		httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+			func(req *http.Request) (*http.Response, error) {
+				resp := httpmock.NewStringResponse(http.StatusTooManyRequests, `{"error_code": 429, "message": "Too many requests"}`)
+				if header != "" {
+					resp.Header.Set("Retry-After", header)
+				}
+				return resp, nil
+			})
+		_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+		return err
+	}
+
+	wait, ok := RetryAfter(rateLimited("7"))
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Second, wait)
+
+	wait, ok = RetryAfter(rateLimited(time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)))
+	assert.True(t, ok)
+	assert.True(t, wait > 28*time.Second && wait <= 30*time.Second)
+
+	wait, ok = RetryAfter(rateLimited(""))
+	assert.True(t, ok)
+	assert.Equal(t, defaultRetryAfter, wait)
+}
+
+func TestRetryAfter_NotRateLimited(t *testing.T) {
+	_, ok := RetryAfter(&SendpulseError{HttpCode: http.StatusBadRequest})
+	assert.False(t, ok)
+
+	_, ok = RetryAfter(nil)
+	assert.False(t, ok)
+}
@@ -1,20 +1,52 @@
 package sendpulse
 
+import (
+	"context"
+	"encoding/json"
+)
+
+// Version is the SDK version reported to SendPulse in the User-Agent header
+const Version = "1.0.0"
+
 type SendpulseClient struct {
-	client *client
-	Emails Emails
+	client    *client
+	Emails    Emails
+	Balance   balance
+	SMTP      smtp
+	SMS       sms
+	Viber     viber
+	Telegram  telegram
+	WhatsApp  whatsapp
+	Messenger messenger
+	Instagram instagram
+	Push      push
+	Account   account
 }
 
-func ApiClient(config Config) (*SendpulseClient, error) {
+func ApiClient(config Config, opts ...Option) (*SendpulseClient, error) {
 	if config.Timeout == 0 {
 		config.Timeout = 5
 	}
 
-	c := NewClient(config)
+	c := NewClient(config, opts...)
 
 	b := books{c}
 	automation := automation360{c}
 	camp := campaigns{c}
+	bl := blacklist{c}
+	snd := senders{c}
+	tpl := templates{c}
+	vrf := verifier{c}
+	bal := balance{c}
+	smtpService := smtp{c}
+	smsService := sms{c}
+	viberService := viber{c}
+	telegramService := telegram{chatbot{c, "telegram"}}
+	whatsappService := whatsapp{chatbot{c, "whatsapp"}}
+	messengerService := messenger{chatbot{c, "messenger"}}
+	instagramService := instagram{chatbot{c, "instagram"}}
+	pushService := push{c}
+	accountService := account{c}
 
 	spClient := &SendpulseClient{
 		client: c,
@@ -22,8 +54,85 @@ func ApiClient(config Config) (*SendpulseClient, error) {
 			Books:         b,
 			Automation360: automation,
 			Campaigns:     camp,
+			Blacklist:     bl,
+			Senders:       snd,
+			Templates:     tpl,
+			Verifier:      vrf,
 		},
+		Balance:   bal,
+		SMTP:      smtpService,
+		SMS:       smsService,
+		Viber:     viberService,
+		Telegram:  telegramService,
+		WhatsApp:  whatsappService,
+		Messenger: messengerService,
+		Instagram: instagramService,
+		Push:      pushService,
+		Account:   accountService,
 	}
 
 	return spClient, nil
 }
+
+// Chatbots groups the chatbot channels
+type Chatbots struct {
+	Telegram  telegram
+	WhatsApp  whatsapp
+	Messenger messenger
+	Instagram instagram
+}
+
+// Email returns the email services
+func (c *SendpulseClient) Email() *Emails {
+	return &c.Emails
+}
+
+// AddressBooks returns the address books service, a shortcut for Emails.Books
+func (c *SendpulseClient) AddressBooks() *books {
+	return &c.Emails.Books
+}
+
+// Chatbots returns the chatbot channels. They share the client, and therefore the token, with the rest of the services
+func (c *SendpulseClient) Chatbots() *Chatbots {
+	return &Chatbots{
+		Telegram:  c.Telegram,
+		WhatsApp:  c.WhatsApp,
+		Messenger: c.Messenger,
+		Instagram: c.Instagram,
+	}
+}
+
+// Do is an escape hatch for endpoints the SDK doesn't wrap yet. It sends an authorized request to path
+// (relative to the API base URL, e.g. "/addressbooks/1/emails") with params encoded in the query for GET
+// and in the form for other methods, and decodes the JSON response into out unless it's nil.
+// Token refresh, retries and error parsing work the same as for the SDK methods: failed requests return *SendpulseError
+func (c *SendpulseClient) Do(method string, path string, params map[string]interface{}, out interface{}) error {
+	return c.DoContext(context.Background(), method, path, params, out)
+}
+
+func (c *SendpulseClient) DoContext(ctx context.Context, method string, path string, params map[string]interface{}, out interface{}) error {
+	body, err := c.client.makeRequest(ctx, path, method, params, true)
+	if err != nil {
+		return err
+	}
+
+	if out == nil || len(body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return c.client.invalidResponse(method, path, body, err.Error())
+	}
+	return nil
+}
+
+// Ping checks the credentials and the connection to SendPulse, e.g. for a readiness probe. It gets a token
+// if none is cached and makes one cheap authorized request (the balance). Failures return *SendpulseError:
+// IsAuthError reports bad credentials, and network failures have HttpCode 503
+func (c *SendpulseClient) Ping() error {
+	return c.PingContext(context.Background())
+}
+
+func (c *SendpulseClient) PingContext(ctx context.Context) error {
+	_, err := c.client.makeRequest(ctx, "/balance", "GET", nil, true)
+	return err
+}
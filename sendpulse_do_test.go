@@ -0,0 +1,88 @@
+package sendpulse
+
+import (
+	"errors"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestSendpulseClient_Do_Get(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var authorization string
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks/1/emails?limit=5",
+		func(req *http.Request) (*http.Response, error) {
+			authorization = req.Header.Get("Authorization")
+			return httpmock.NewStringResponse(http.StatusOK, `[{"email": "john@example.com", "status": 1}]`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = "token"
+
+	var out []struct {
+		Email  string `json:"email"`
+		Status int    `json:"status"`
+	}
+	err := spClient.Do("GET", "/addressbooks/1/emails", map[string]interface{}{"limit": 5}, &out)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer token", authorization)
+	assert.Len(t, out, 1)
+	assert.Equal(t, "john@example.com", out[0].Email)
+}
+
+func TestSendpulseClient_Do_PostWithoutOut(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var name string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/addressbooks",
+		func(req *http.Request) (*http.Response, error) {
+			req.ParseForm()
+			name = req.PostForm.Get("bookName")
+			return httpmock.NewStringResponse(http.StatusOK, `{"id": 1}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.Do("POST", "/addressbooks", map[string]interface{}{"bookName": "Customers"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Customers", name)
+}
+
+func TestSendpulseClient_Do_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/unknown",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"error_code": 404, "message": "Not found"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	var out map[string]interface{}
+	err := spClient.Do("GET", "/unknown", nil, &out)
+	var spErr *SendpulseError
+	assert.True(t, errors.As(err, &spErr))
+	assert.Equal(t, http.StatusNotFound, spErr.HttpCode)
+	assert.Equal(t, "Not found", spErr.ErrorDescription)
+}
@@ -0,0 +1,42 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestSendpulseClient_Ping(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusOK, `{"access_token": "testtoken", "token_type": "Bearer", "expires_in": 3600}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/balance",
+		httpmock.NewStringResponder(http.StatusOK, `{"currency": "USD", "balance_main": "10.00", "balance_bonus": "0.00"}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+
+	assert.NoError(t, spClient.Ping())
+
+	callCounts := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, callCounts["POST "+apiBaseUrl+"/oauth/access_token"])
+	assert.Equal(t, 1, callCounts["GET "+apiBaseUrl+"/balance"])
+}
+
+func TestSendpulseClient_Ping_BadCredentials(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusUnauthorized, `{"error": "invalid_client", "message": "Client authentication failed."}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+
+	err := spClient.Ping()
+	assert.Error(t, err)
+	assert.True(t, IsAuthError(err))
+	assert.Equal(t, 0, httpmock.GetCallCountInfo()["GET "+apiBaseUrl+"/balance"])
+}
@@ -45,3 +45,31 @@ func TestApiClient_NullTimeout(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 5, client.client.config.Timeout)
 }
+
+func TestApiClient_ServiceAccessors(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/telegram/bots",
+		httpmock.NewStringResponder(http.StatusOK, `{"success": true, "data": []}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks/1",
+		httpmock.NewStringResponder(http.StatusOK, `[{"id": 1, "name": "Customers"}]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(10),
+		Secret:  fake.CharactersN(10),
+		Timeout: 5,
+	}
+	client, _ := ApiClient(config)
+	client.client.token = fake.Word()
+
+	assert.Equal(t, &client.Emails, client.Email())
+	assert.Equal(t, &client.Emails.Books, client.AddressBooks())
+	assert.Equal(t, client.client, client.Chatbots().WhatsApp.Client)
+
+	_, err := client.Chatbots().Telegram.Bots()
+	assert.NoError(t, err)
+	book, err := client.AddressBooks().Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, "Customers", book.Name)
+}
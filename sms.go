@@ -0,0 +1,541 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type sms struct {
+	Client *client
+}
+
+var (
+	// ErrEmptyPhones is returned without calling the API when an SMS gets no phones
+	ErrEmptyPhones = errors.New("phones list is empty")
+	// ErrEmptySMSBody is returned without calling the API when the SMS text is empty
+	ErrEmptySMSBody = errors.New("sms body is empty")
+)
+
+type SMSResult struct {
+	ID int
+	// Tariff is the campaign cost preview. It's zero if SendPulse doesn't return it
+	Tariff float64
+}
+
+type smsResultRaw struct {
+	CampaignID interface{} `json:"campaign_id"`
+	Tariff     interface{} `json:"tariff"`
+}
+
+// SendByList sends an SMS to the phones. A nil sendDate means sending right away
+func (s *sms) SendByList(sender string, phones []string, body string, sendDate *time.Time) (*SMSResult, error) {
+	return s.SendByListContext(context.Background(), sender, phones, body, sendDate)
+}
+
+func (s *sms) SendByListContext(ctx context.Context, sender string, phones []string, body string, sendDate *time.Time) (*SMSResult, error) {
+	return s.SendByListIdempotentContext(ctx, sender, phones, body, sendDate, "")
+}
+
+// SendByListIdempotent is SendByList where a repeated send of the same SMS with the same idempotencyKey returns
+// the first result instead of sending it again. As for SMTPEmail.IdempotencyKey, it's deduplicated on the client side:
+// only within the same SendpulseClient for 10 minutes after a successful send
+func (s *sms) SendByListIdempotent(sender string, phones []string, body string, sendDate *time.Time, idempotencyKey string) (*SMSResult, error) {
+	return s.SendByListIdempotentContext(context.Background(), sender, phones, body, sendDate, idempotencyKey)
+}
+
+func (s *sms) SendByListIdempotentContext(ctx context.Context, sender string, phones []string, body string, sendDate *time.Time, idempotencyKey string) (*SMSResult, error) {
+	if len(phones) == 0 {
+		return nil, ErrEmptyPhones
+	}
+
+	payload := map[string]interface{}{
+		"sender": sender,
+		"phones": phones,
+	}
+	return s.send(ctx, "/sms/send", payload, body, sendDate, idempotencyKey)
+}
+
+// SendByBook sends an SMS to the phones of the address book. A nil sendDate means sending right away
+func (s *sms) SendByBook(sender string, addressBookID int, body string, sendDate *time.Time) (*SMSResult, error) {
+	return s.SendByBookContext(context.Background(), sender, addressBookID, body, sendDate)
+}
+
+func (s *sms) SendByBookContext(ctx context.Context, sender string, addressBookID int, body string, sendDate *time.Time) (*SMSResult, error) {
+	return s.SendByBookIdempotentContext(ctx, sender, addressBookID, body, sendDate, "")
+}
+
+// SendByBookIdempotent is SendByBook deduplicated by idempotencyKey, see SendByListIdempotent
+func (s *sms) SendByBookIdempotent(sender string, addressBookID int, body string, sendDate *time.Time, idempotencyKey string) (*SMSResult, error) {
+	return s.SendByBookIdempotentContext(context.Background(), sender, addressBookID, body, sendDate, idempotencyKey)
+}
+
+func (s *sms) SendByBookIdempotentContext(ctx context.Context, sender string, addressBookID int, body string, sendDate *time.Time, idempotencyKey string) (*SMSResult, error) {
+	payload := map[string]interface{}{
+		"sender":        sender,
+		"addressBookId": addressBookID,
+	}
+	return s.send(ctx, "/sms/campaigns", payload, body, sendDate, idempotencyKey)
+}
+
+func (s *sms) send(ctx context.Context, path string, payload map[string]interface{}, text string, sendDate *time.Time, key string) (*SMSResult, error) {
+	if text == "" {
+		return nil, ErrEmptySMSBody
+	}
+
+	payload["body"] = text
+	if sendDate != nil && !sendDate.IsZero() {
+		payload["date"] = formatSendDate(*sendDate)
+	}
+
+	if key == "" {
+		return s.sendPayload(ctx, path, payload)
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.Client.sent.do(ctx, idempotencyKey(key, encoded), func() (interface{}, error) {
+		return s.sendPayload(ctx, path, payload)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SMSResult), nil
+}
+
+func (s *sms) sendPayload(ctx context.Context, path string, payload map[string]interface{}) (*SMSResult, error) {
+	body, err := s.Client.makeJSONRequest(ctx, path, "POST", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Client.checkResult("POST", path, body); err != nil {
+		return nil, err
+	}
+
+	var raw smsResultRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, s.Client.invalidResponse("POST", path, body, err.Error())
+	}
+
+	return &SMSResult{
+		ID:     toInt(raw.CampaignID),
+		Tariff: toFloat(raw.Tariff),
+	}, nil
+}
+
+// SMSStatus is the state of an SMS campaign or of a single message of it
+type SMSStatus int
+
+const (
+	SMSStatusNew         SMSStatus = 0
+	SMSStatusSending     SMSStatus = 1
+	SMSStatusSent        SMSStatus = 2
+	SMSStatusDelivered   SMSStatus = 3
+	SMSStatusUndelivered SMSStatus = 4
+	SMSStatusCanceled    SMSStatus = 5
+)
+
+func (s SMSStatus) String() string {
+	switch s {
+	case SMSStatusNew:
+		return "new"
+	case SMSStatusSending:
+		return "sending"
+	case SMSStatusSent:
+		return "sent"
+	case SMSStatusDelivered:
+		return "delivered"
+	case SMSStatusUndelivered:
+		return "undelivered"
+	case SMSStatusCanceled:
+		return "canceled"
+	}
+	return fmt.Sprintf("unknown (%d)", int(s))
+}
+
+type SMSCampaign struct {
+	ID            int
+	AddressBookID int
+	SenderName    string
+	Status        SMSStatus
+	Price         float64
+	Currency      string
+	SendDate      time.Time
+	Created       time.Time
+}
+
+// SMSCampaignDetail is an SMS campaign with the delivery counters computed from its messages
+type SMSCampaignDetail struct {
+	SMSCampaign
+	RecipientsCount int
+	SentCount       int
+	DeliveredCount  int
+}
+
+type smsCampaignRaw struct {
+	ID            interface{} `json:"id"`
+	AddressBookID interface{} `json:"address_book_id"`
+	SenderName    string      `json:"sender_name"`
+	Status        interface{} `json:"status"`
+	CompanyPrice  interface{} `json:"company_price"`
+	Currency      string      `json:"company_currency"`
+	SendDate      string      `json:"send_date"`
+	DateCreated   string      `json:"date_created"`
+}
+
+type smsCampaignDetailRaw struct {
+	smsCampaignRaw
+	Currency       string `json:"currency"`
+	TaskPhonesInfo []struct {
+		Phone         interface{} `json:"phone"`
+		Status        interface{} `json:"status"`
+		StatusExplain string      `json:"status_explain"`
+	} `json:"task_phones_info"`
+}
+
+func (raw smsCampaignRaw) campaign() SMSCampaign {
+	campaign := SMSCampaign{
+		ID:            toInt(raw.ID),
+		AddressBookID: toInt(raw.AddressBookID),
+		SenderName:    raw.SenderName,
+		Status:        SMSStatus(toInt(raw.Status)),
+		Price:         toFloat(raw.CompanyPrice),
+		Currency:      raw.Currency,
+	}
+	if sendDate, err := time.Parse(sendDateLayout, raw.SendDate); err == nil {
+		campaign.SendDate = sendDate
+	}
+	if created, err := time.Parse(sendDateLayout, raw.DateCreated); err == nil {
+		campaign.Created = created
+	}
+	return campaign
+}
+
+// Campaigns returns SMS campaigns created in the date range. Zero dates mean the recent campaigns
+func (s *sms) Campaigns(dateFrom time.Time, dateTo time.Time) ([]SMSCampaign, error) {
+	return s.CampaignsContext(context.Background(), dateFrom, dateTo)
+}
+
+func (s *sms) CampaignsContext(ctx context.Context, dateFrom time.Time, dateTo time.Time) ([]SMSCampaign, error) {
+	path := "/sms/campaigns/list"
+
+	if !dateFrom.IsZero() && !dateTo.IsZero() && dateFrom.After(dateTo) {
+		return nil, ErrInvalidDateRange
+	}
+
+	data := make(map[string]interface{})
+	if !dateFrom.IsZero() {
+		data["dateFrom"] = formatSendDate(dateFrom)
+	}
+	if !dateTo.IsZero() {
+		data["dateTo"] = formatSendDate(dateTo)
+	}
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var respData struct {
+		Data []smsCampaignRaw `json:"data"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	campaigns := make([]SMSCampaign, 0)
+	for _, raw := range respData.Data {
+		campaigns = append(campaigns, raw.campaign())
+	}
+	return campaigns, nil
+}
+
+func (s *sms) CampaignInfo(campaignID int) (*SMSCampaignDetail, error) {
+	return s.CampaignInfoContext(context.Background(), campaignID)
+}
+
+func (s *sms) CampaignInfoContext(ctx context.Context, campaignID int) (*SMSCampaignDetail, error) {
+	raw, err := s.campaignDetail(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	if raw.Currency != "" && raw.smsCampaignRaw.Currency == "" {
+		raw.smsCampaignRaw.Currency = raw.Currency
+	}
+	detail := SMSCampaignDetail{
+		SMSCampaign:     raw.campaign(),
+		RecipientsCount: len(raw.TaskPhonesInfo),
+	}
+	for _, phone := range raw.TaskPhonesInfo {
+		switch SMSStatus(toInt(phone.Status)) {
+		case SMSStatusDelivered:
+			detail.DeliveredCount++
+			detail.SentCount++
+		case SMSStatusSent, SMSStatusUndelivered:
+			detail.SentCount++
+		}
+	}
+	return &detail, nil
+}
+
+func (s *sms) campaignDetail(ctx context.Context, campaignID int) (*smsCampaignDetailRaw, error) {
+	path := fmt.Sprintf("/sms/campaigns/info/%d", campaignID)
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var respData struct {
+		Data smsCampaignDetailRaw `json:"data"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+	return &respData.Data, nil
+}
+
+// CancelCampaign cancels a scheduled SMS campaign
+func (s *sms) CancelCampaign(campaignID int) error {
+	return s.CancelCampaignContext(context.Background(), campaignID)
+}
+
+func (s *sms) CancelCampaignContext(ctx context.Context, campaignID int) error {
+	path := fmt.Sprintf("/sms/campaigns/cancel/%d", campaignID)
+
+	body, err := s.Client.makeRequest(ctx, path, "PATCH", nil, true)
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("PATCH", path, body)
+}
+
+// InvalidPhonesError is returned without calling the API when some phones aren't made of digits (and an optional leading +)
+type InvalidPhonesError struct {
+	Phones []string
+}
+
+func (e *InvalidPhonesError) Error() string {
+	return fmt.Sprintf("invalid phones: %s", strings.Join(e.Phones, ", "))
+}
+
+// validatePhones checks the phones list is not empty and every phone looks like a number
+func validatePhones(phones []string) error {
+	if len(phones) == 0 {
+		return ErrEmptyPhones
+	}
+
+	var invalid []string
+	for _, phone := range phones {
+		if !isPhone(phone) {
+			invalid = append(invalid, phone)
+		}
+	}
+	if len(invalid) != 0 {
+		return &InvalidPhonesError{Phones: invalid}
+	}
+	return nil
+}
+
+func isPhone(phone string) bool {
+	digits := strings.TrimPrefix(phone, "+")
+	if digits == "" {
+		return false
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// PhoneContact is a phone with the address book variables to store for it
+type PhoneContact struct {
+	Phone     string
+	Variables map[string]interface{}
+}
+
+type phoneVariableRaw struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// AddPhones adds phones to the address book
+func (s *sms) AddPhones(addressBookID int, phones []string) error {
+	return s.AddPhonesContext(context.Background(), addressBookID, phones)
+}
+
+func (s *sms) AddPhonesContext(ctx context.Context, addressBookID int, phones []string) error {
+	path := "/sms/numbers"
+
+	if err := validatePhones(phones); err != nil {
+		return err
+	}
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"addressBookId": addressBookID,
+		"phones":        phones,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("POST", path, body)
+}
+
+// AddPhonesWithVariables adds phones to the address book together with their variables.
+// Variable types are derived from the values: numbers, time.Time (dates) and strings
+func (s *sms) AddPhonesWithVariables(addressBookID int, phones []PhoneContact) error {
+	return s.AddPhonesWithVariablesContext(context.Background(), addressBookID, phones)
+}
+
+func (s *sms) AddPhonesWithVariablesContext(ctx context.Context, addressBookID int, phones []PhoneContact) error {
+	path := "/sms/numbers/variables"
+
+	numbers := make([]string, 0, len(phones))
+	for _, phone := range phones {
+		numbers = append(numbers, phone.Phone)
+	}
+	if err := validatePhones(numbers); err != nil {
+		return err
+	}
+
+	phonesData := make(map[string][][]phoneVariableRaw)
+	for _, phone := range phones {
+		variables := make([]phoneVariableRaw, 0, len(phone.Variables))
+		for name, value := range phone.Variables {
+			variables = append(variables, newPhoneVariable(name, value))
+		}
+		phonesData[phone.Phone] = [][]phoneVariableRaw{variables}
+	}
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"addressBookId": addressBookID,
+		"phones":        phonesData,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("POST", path, body)
+}
+
+func newPhoneVariable(name string, value interface{}) phoneVariableRaw {
+	switch v := value.(type) {
+	case time.Time:
+		return phoneVariableRaw{Name: name, Type: "date", Value: v.Format(sendDateLayout)}
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return phoneVariableRaw{Name: name, Type: "number", Value: v}
+	}
+	return phoneVariableRaw{Name: name, Type: "string", Value: fmt.Sprint(value)}
+}
+
+// DeletePhones removes phones from the address book
+func (s *sms) DeletePhones(addressBookID int, phones []string) error {
+	return s.DeletePhonesContext(context.Background(), addressBookID, phones)
+}
+
+func (s *sms) DeletePhonesContext(ctx context.Context, addressBookID int, phones []string) error {
+	path := "/sms/numbers"
+
+	if err := validatePhones(phones); err != nil {
+		return err
+	}
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "DELETE", map[string]interface{}{
+		"addressBookId": addressBookID,
+		"phones":        phones,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("DELETE", path, body)
+}
+
+// AddToBlacklist stops sending SMS to the phones
+func (s *sms) AddToBlacklist(phones []string, comment string) error {
+	return s.AddToBlacklistContext(context.Background(), phones, comment)
+}
+
+func (s *sms) AddToBlacklistContext(ctx context.Context, phones []string, comment string) error {
+	path := "/sms/black_list"
+
+	if err := validatePhones(phones); err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"phones": phones,
+	}
+	if comment != "" {
+		payload["description"] = comment
+	}
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "POST", payload)
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("POST", path, body)
+}
+
+func (s *sms) RemoveFromBlacklist(phones []string) error {
+	return s.RemoveFromBlacklistContext(context.Background(), phones)
+}
+
+func (s *sms) RemoveFromBlacklistContext(ctx context.Context, phones []string) error {
+	path := "/sms/black_list"
+
+	if err := validatePhones(phones); err != nil {
+		return err
+	}
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "DELETE", map[string]interface{}{
+		"phones": phones,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("DELETE", path, body)
+}
+
+// Blacklist returns the phones SMS aren't sent to
+func (s *sms) Blacklist() ([]string, error) {
+	return s.BlacklistContext(context.Background())
+}
+
+func (s *sms) BlacklistContext(ctx context.Context) ([]string, error) {
+	path := "/sms/black_list"
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var respData struct {
+		Data []struct {
+			Phone interface{} `json:"phone"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	phones := make([]string, 0, len(respData.Data))
+	for _, item := range respData.Data {
+		phones = append(phones, toString(item.Phone))
+	}
+	return phones, nil
+}
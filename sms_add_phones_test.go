@@ -0,0 +1,56 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSMS_AddPhones_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/sms/numbers",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "counters": {"added": 2}}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.SMS.AddPhones(12345, []string{"380501234567", "+380507654321"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"addressBookId": 12345, "phones": ["380501234567", "+380507654321"]}`, requestBody)
+}
+
+func TestSMS_AddPhones_Invalid(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.SMS.AddPhones(12345, []string{"380501234567", "38050-123", "", "+"})
+	phonesErr, isPhonesError := err.(*InvalidPhonesError)
+	assert.True(t, isPhonesError)
+	assert.Equal(t, []string{"38050-123", "", "+"}, phonesErr.Phones)
+
+	assert.Equal(t, ErrEmptyPhones, spClient.SMS.AddPhones(12345, nil))
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
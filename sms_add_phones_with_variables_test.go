@@ -0,0 +1,60 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSMS_AddPhonesWithVariables_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/sms/numbers/variables",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.SMS.AddPhonesWithVariables(12345, []PhoneContact{
+		{Phone: "380501234567", Variables: map[string]interface{}{"name": "Alice"}},
+		{Phone: "380507654321", Variables: map[string]interface{}{"age": 30}},
+		{Phone: "380500000000", Variables: map[string]interface{}{"birthday": time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC)}},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"addressBookId": 12345,
+		"phones": {
+			"380501234567": [[{"name": "name", "type": "string", "value": "Alice"}]],
+			"380507654321": [[{"name": "age", "type": "number", "value": 30}]],
+			"380500000000": [[{"name": "birthday", "type": "date", "value": "1990-01-02 00:00:00"}]]
+		}
+	}`, requestBody)
+}
+
+func TestSMS_AddPhonesWithVariables_Invalid(t *testing.T) {
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+
+	err := spClient.SMS.AddPhonesWithVariables(12345, []PhoneContact{{Phone: "phone"}})
+	_, isPhonesError := err.(*InvalidPhonesError)
+	assert.True(t, isPhonesError)
+}
@@ -0,0 +1,80 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSMS_AddToBlacklist_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/sms/black_list",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.SMS.AddToBlacklist([]string{"380501234567"}, "Opted out")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"phones": ["380501234567"], "description": "Opted out"}`, requestBody)
+}
+
+func TestSMS_RemoveFromBlacklist_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("DELETE", apiBaseUrl+"/sms/black_list",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.SMS.RemoveFromBlacklist([]string{"380501234567"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"phones": ["380501234567"]}`, requestBody)
+}
+
+func TestSMS_Blacklist_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/sms/black_list",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true, "data": [{"phone": 380501234567, "description": "Opted out", "add_date": "2030-01-02 07:30:00"}, {"phone": "+380507654321"}]}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	phones, err := spClient.SMS.Blacklist()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"380501234567", "+380507654321"}, phones)
+}
@@ -0,0 +1,104 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestSMS_CampaignInfo_Delivered(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/sms/campaigns/info/2183624",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true, "data": {
+			"id": 2183624,
+			"address_book_id": 12345,
+			"sender_name": "Shop",
+			"status": 3,
+			"company_price": 0.3,
+			"currency": "USD",
+			"send_date": "2030-01-02 07:30:00",
+			"date_created": "2030-01-02 07:29:00",
+			"task_phones_info": [
+				{"phone": 380501234567, "status": 3},
+				{"phone": 380507654321, "status": 3},
+				{"phone": 380500000000, "status": 4}
+			]
+		}}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	detail, err := spClient.SMS.CampaignInfo(2183624)
+	assert.NoError(t, err)
+	assert.Equal(t, 2183624, detail.ID)
+	assert.Equal(t, 12345, detail.AddressBookID)
+	assert.Equal(t, SMSStatusDelivered, detail.Status)
+	assert.Equal(t, 0.3, detail.Price)
+	assert.Equal(t, "USD", detail.Currency)
+	assert.Equal(t, 3, detail.RecipientsCount)
+	assert.Equal(t, 3, detail.SentCount)
+	assert.Equal(t, 2, detail.DeliveredCount)
+}
+
+func TestSMS_CampaignInfo_Canceled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/sms/campaigns/info/2183625",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true, "data": {
+			"id": "2183625",
+			"sender_name": "Shop",
+			"status": "5",
+			"company_price": "0",
+			"company_currency": "USD",
+			"task_phones_info": [{"phone": "380501234567", "status": 5}]
+		}}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	detail, err := spClient.SMS.CampaignInfo(2183625)
+	assert.NoError(t, err)
+	assert.Equal(t, SMSStatusCanceled, detail.Status)
+	assert.Equal(t, "canceled", detail.Status.String())
+	assert.Equal(t, 1, detail.RecipientsCount)
+	assert.Equal(t, 0, detail.SentCount)
+	assert.Equal(t, 0, detail.DeliveredCount)
+	assert.True(t, detail.SendDate.IsZero())
+}
+
+func TestSMS_CampaignInfo_NotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/sms/campaigns/info/1",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"error_code": 404, "message": "Campaign not found"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	detail, err := spClient.SMS.CampaignInfo(1)
+	assert.Nil(t, detail)
+	spErr, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+	assert.Equal(t, http.StatusNotFound, spErr.HttpCode)
+}
@@ -0,0 +1,89 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestSMS_Campaigns_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var query url.Values
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/sms/campaigns/list",
+		func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "data": [{
+				"id": 2183624,
+				"address_book_id": 0,
+				"sender_name": "Shop",
+				"status": 3,
+				"company_price": "0.10",
+				"company_currency": "USD",
+				"send_date": "2030-01-02 07:30:00",
+				"date_created": "2030-01-02 07:29:00"
+			}]}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	campaigns, err := spClient.SMS.Campaigns(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2030, 1, 31, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, []SMSCampaign{{
+		ID:         2183624,
+		SenderName: "Shop",
+		Status:     SMSStatusDelivered,
+		Price:      0.1,
+		Currency:   "USD",
+		SendDate:   time.Date(2030, 1, 2, 7, 30, 0, 0, time.UTC),
+		Created:    time.Date(2030, 1, 2, 7, 29, 0, 0, time.UTC),
+	}}, campaigns)
+	assert.Equal(t, "2030-01-01 00:00:00", query.Get("dateFrom"))
+	assert.Equal(t, "2030-01-31 00:00:00", query.Get("dateTo"))
+}
+
+func TestSMS_Campaigns_Recent(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/sms/campaigns/list",
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "", req.URL.RawQuery)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "data": []}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	campaigns, err := spClient.SMS.Campaigns(time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, []SMSCampaign{}, campaigns)
+}
+
+func TestSMS_Campaigns_InvalidDateRange(t *testing.T) {
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+
+	_, err := spClient.SMS.Campaigns(time.Now(), time.Now().Add(-time.Hour))
+	assert.Equal(t, ErrInvalidDateRange, err)
+}
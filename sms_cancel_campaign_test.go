@@ -0,0 +1,47 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestSMS_CancelCampaign_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("PATCH", apiBaseUrl+"/sms/campaigns/cancel/2183624",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	assert.NoError(t, spClient.SMS.CancelCampaign(2183624))
+}
+
+func TestSMS_CancelCampaign_InvalidResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("PATCH", apiBaseUrl+"/sms/campaigns/cancel/2183624",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": false}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.SMS.CancelCampaign(2183624)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
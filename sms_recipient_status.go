@@ -0,0 +1,55 @@
+package sendpulse
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrSMSRecipientNotFound is returned by SMS.RecipientStatus when the phone isn't a recipient of the campaign
+var ErrSMSRecipientNotFound = errors.New("phone is not a recipient of the sms campaign")
+
+// SMSDeliveryStatus is the delivery status of an SMS campaign message to one phone.
+// Error is the explanation reported by the carrier, usually set for undelivered messages only
+type SMSDeliveryStatus struct {
+	Phone  string
+	Status SMSStatus
+	Error  string
+}
+
+// RecipientStatus returns the delivery status of the campaign message sent to the phone.
+// Phones are compared by their digits only, so "+1 555 0100" matches "15550100"
+func (s *sms) RecipientStatus(campaignID int, phone string) (*SMSDeliveryStatus, error) {
+	return s.RecipientStatusContext(context.Background(), campaignID, phone)
+}
+
+func (s *sms) RecipientStatusContext(ctx context.Context, campaignID int, phone string) (*SMSDeliveryStatus, error) {
+	raw, err := s.campaignDetail(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := phoneDigits(phone)
+	for _, recipient := range raw.TaskPhonesInfo {
+		recipientPhone := toString(recipient.Phone)
+		if wanted == "" || phoneDigits(recipientPhone) != wanted {
+			continue
+		}
+		return &SMSDeliveryStatus{
+			Phone:  recipientPhone,
+			Status: SMSStatus(toInt(recipient.Status)),
+			Error:  recipient.StatusExplain,
+		}, nil
+	}
+	return nil, ErrSMSRecipientNotFound
+}
+
+// phoneDigits drops everything but the digits of the phone
+func phoneDigits(phone string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+}
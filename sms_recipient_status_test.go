@@ -0,0 +1,39 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestSMS_RecipientStatus(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/sms/campaigns/info/2183624",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true, "data": {
+			"id": 2183624,
+			"sender_name": "Shop",
+			"status": 3,
+			"task_phones_info": [
+				{"phone": 15550100123, "status": 3},
+				{"phone": "380500000000", "status": 4, "status_explain": "Absent subscriber"}
+			]
+		}}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	status, err := spClient.SMS.RecipientStatus(2183624, "+1 555 0100123")
+	assert.NoError(t, err)
+	assert.Equal(t, &SMSDeliveryStatus{Phone: "15550100123", Status: SMSStatusDelivered}, status)
+
+	status, err = spClient.SMS.RecipientStatus(2183624, "380500000000")
+	assert.NoError(t, err)
+	assert.Equal(t, &SMSDeliveryStatus{Phone: "380500000000", Status: SMSStatusUndelivered, Error: "Absent subscriber"}, status)
+
+	_, err = spClient.SMS.RecipientStatus(2183624, "+380501234567")
+	assert.Equal(t, ErrSMSRecipientNotFound, err)
+}
@@ -0,0 +1,57 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSMS_SendByBook_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/sms/campaigns",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "campaign_id": "2183625"}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.SMS.SendByBook("Shop", 12345, "Sale starts today", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2183625, result.ID)
+	assert.JSONEq(t, `{"sender": "Shop", "addressBookId": 12345, "body": "Sale starts today"}`, requestBody)
+}
+
+func TestSMS_SendByBook_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/sms/campaigns",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"error_code": 400, "message": "Sender is not valid"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.SMS.SendByBook("Shop", 12345, "Sale starts today", nil)
+	assert.Nil(t, result)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
@@ -0,0 +1,112 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSMS_SendByList_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/sms/send",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "campaign_id": 2183624, "tariff": "0.05"}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	sendDate := time.Date(2030, 1, 2, 7, 30, 0, 0, time.UTC)
+	result, err := spClient.SMS.SendByList("Shop", []string{"380501234567", "380507654321"}, "Your code: 1234", &sendDate)
+	assert.NoError(t, err)
+	assert.Equal(t, SMSResult{ID: 2183624, Tariff: 0.05}, *result)
+	assert.JSONEq(t, `{
+		"sender": "Shop",
+		"phones": ["380501234567", "380507654321"],
+		"body": "Your code: 1234",
+		"date": "2030-01-02 07:30:00"
+	}`, requestBody)
+}
+
+func TestSMS_SendByList_Immediate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/sms/send",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "campaign_id": 2183624}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.SMS.SendByList("Shop", []string{"380501234567"}, "Your code: 1234", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, SMSResult{ID: 2183624}, *result)
+	assert.JSONEq(t, `{"sender": "Shop", "phones": ["380501234567"], "body": "Your code: 1234"}`, requestBody)
+}
+
+func TestSMS_SendByList_Validation(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.SMS.SendByList("Shop", nil, "Your code: 1234", nil)
+	assert.Equal(t, ErrEmptyPhones, err)
+
+	_, err = spClient.SMS.SendByList("Shop", []string{"380501234567"}, "", nil)
+	assert.Equal(t, ErrEmptySMSBody, err)
+
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestSMS_SendByListIdempotent(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/sms/send",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true, "campaign_id": 2183624}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	first, err := spClient.SMS.SendByListIdempotent("Shop", []string{"380501234567"}, "Your code: 1234", nil, "order-1")
+	assert.NoError(t, err)
+	second, err := spClient.SMS.SendByListIdempotent("Shop", []string{"380501234567"}, "Your code: 1234", nil, "order-1")
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	_, err = spClient.SMS.SendByListIdempotent("Shop", []string{"380501234567"}, "Your code: 5678", nil, "order-1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
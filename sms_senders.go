@@ -0,0 +1,94 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidSMSSenderName is returned without calling the API when a sender name isn't 1 to 11 latin letters, digits or spaces
+var ErrInvalidSMSSenderName = errors.New("sms sender name must be 1 to 11 latin letters, digits or spaces")
+
+// SMSSender is an alphanumeric sender ID. Only approved senders can be used in SendByList and SendByBook
+type SMSSender struct {
+	Name   string
+	Status string
+}
+
+// IsApproved reports whether the sender passed moderation
+func (s SMSSender) IsApproved() bool {
+	switch strings.ToLower(s.Status) {
+	case "active", "approved":
+		return true
+	}
+	return false
+}
+
+type smsSenderRaw struct {
+	Name   string      `json:"sender"`
+	Status interface{} `json:"status"`
+}
+
+// Senders returns the SMS sender names of the account with their approval status
+func (s *sms) Senders() ([]SMSSender, error) {
+	return s.SendersContext(context.Background())
+}
+
+func (s *sms) SendersContext(ctx context.Context) ([]SMSSender, error) {
+	path := "/sms/senders"
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	senders := make([]SMSSender, 0)
+	if isEmptyCollection(body) {
+		return senders, nil
+	}
+
+	var respData []smsSenderRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range respData {
+		senders = append(senders, SMSSender{Name: raw.Name, Status: toString(raw.Status)})
+	}
+	return senders, nil
+}
+
+// AddSender requests a new SMS sender name. It can be used once approved by SendPulse
+func (s *sms) AddSender(name string) error {
+	return s.AddSenderContext(context.Background(), name)
+}
+
+func (s *sms) AddSenderContext(ctx context.Context, name string) error {
+	path := "/sms/senders"
+
+	if !isSMSSenderName(name) {
+		return ErrInvalidSMSSenderName
+	}
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"sender": name,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("POST", path, body)
+}
+
+func isSMSSenderName(name string) bool {
+	if strings.TrimSpace(name) == "" || len(name) > 11 {
+		return false
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == ' ') {
+			return false
+		}
+	}
+	return true
+}
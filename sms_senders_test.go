@@ -0,0 +1,69 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSms_Senders(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/sms/senders",
+		httpmock.NewStringResponder(http.StatusOK, `[{"sender": "MyShop", "status": "active"}, {"sender": "News24", "status": "moderation"}]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	senders, err := spClient.SMS.Senders()
+	assert.NoError(t, err)
+	assert.Equal(t, []SMSSender{
+		{Name: "MyShop", Status: "active"},
+		{Name: "News24", Status: "moderation"},
+	}, senders)
+	assert.True(t, senders[0].IsApproved())
+	assert.False(t, senders[1].IsApproved())
+}
+
+func TestSms_Senders_Empty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/sms/senders",
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	senders, err := spClient.SMS.Senders()
+	assert.NoError(t, err)
+	assert.Equal(t, []SMSSender{}, senders)
+}
+
+func TestSms_AddSender(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/sms/senders",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	assert.NoError(t, spClient.SMS.AddSender("MyShop"))
+	assert.JSONEq(t, `{"sender": "MyShop"}`, requestBody)
+
+	for _, name := range []string{"", "   ", "TooLongSenderName", "Shop!"} {
+		assert.Equal(t, ErrInvalidSMSSenderName, spClient.SMS.AddSender(name), name)
+	}
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,569 @@
+package sendpulse
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type smtp struct {
+	Client *client
+}
+
+type Recipient struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email"`
+}
+
+type Attachment struct {
+	Filename string
+	Content  []byte
+}
+
+// InlineAttachment is a resource embedded in the HTML, e.g. an image referenced as <img src="cid:logo">
+// for ContentID "logo". Unlike Attachment it isn't shown as a downloadable file
+type InlineAttachment struct {
+	Filename  string
+	ContentID string
+	Content   []byte
+}
+
+type inlineAttachmentRaw struct {
+	Filename    string `json:"filename"`
+	ContentID   string `json:"content_id"`
+	Content     string `json:"content"`
+	Disposition string `json:"disposition"`
+}
+
+// SMTPEmail is a transactional email. HTML and attachments are base64-encoded when sending
+type SMTPEmail struct {
+	From        Recipient
+	To          []Recipient
+	Cc          []Recipient
+	Bcc         []Recipient
+	Subject     string
+	HTML        string
+	Text        string
+	Attachments []Attachment
+	// InlineAttachments are embedded in HTML by content id
+	InlineAttachments []InlineAttachment
+	// IdempotencyKey makes a repeated send of the same email with the same key return the first result
+	// instead of sending it again. The API has no such option, so it only works within the same SendpulseClient
+	// for 10 minutes after a successful send
+	IdempotencyKey string
+}
+
+type SMTPSendResult struct {
+	ID string
+}
+
+type smtpEmailRaw struct {
+	HTML              string            `json:"html,omitempty"`
+	Text              string            `json:"text,omitempty"`
+	Subject           string            `json:"subject"`
+	From              Recipient         `json:"from"`
+	To                []Recipient       `json:"to"`
+	Cc                []Recipient       `json:"cc,omitempty"`
+	Bcc               []Recipient       `json:"bcc,omitempty"`
+	AttachmentsBinary map[string]string `json:"attachments_binary,omitempty"`
+	// Inline resources are sent apart from the attachments, which the API shows as files
+	InlineAttachments []inlineAttachmentRaw `json:"inline_attachments_binary,omitempty"`
+}
+
+type smtpTemplateEmailRaw struct {
+	Subject  string          `json:"subject"`
+	From     Recipient       `json:"from"`
+	To       []Recipient     `json:"to"`
+	Template smtpTemplateRaw `json:"template"`
+}
+
+type smtpTemplateRaw struct {
+	ID        interface{}            `json:"id"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// ErrNoToRecipients is returned by Send without calling the API when the email has no To recipients
+var ErrNoToRecipients = errors.New("email has no To recipients")
+
+// ErrEmptyContentID is returned by Send without calling the API when an inline attachment has no content id
+var ErrEmptyContentID = errors.New("inline attachment has no content id")
+
+// ErrInvalidDateRange is returned without calling the API when the range start is after its end
+var ErrInvalidDateRange = errors.New("invalid date range: from is after to")
+
+// SMTPListParams filters the sent emails log. Zero values mean no filter
+type SMTPListParams struct {
+	Limit     int
+	Offset    int
+	From      time.Time
+	To        time.Time
+	Sender    string
+	Recipient string
+	Status    string
+}
+
+type SMTPEmailInfo struct {
+	ID        string
+	Sender    string
+	Recipient string
+	Subject   string
+	Status    string
+	SendDate  time.Time
+	Opened    int
+	Clicked   int
+}
+
+type smtpEmailInfoRaw struct {
+	ID        string      `json:"id"`
+	Sender    string      `json:"sender"`
+	Recipient string      `json:"recipient"`
+	Subject   string      `json:"subject"`
+	Status    interface{} `json:"status"`
+	SendDate  string      `json:"send_date"`
+	Tracking  struct {
+		Click interface{} `json:"click"`
+		Open  interface{} `json:"open"`
+	} `json:"tracking"`
+}
+
+func (raw smtpEmailInfoRaw) emailInfo() SMTPEmailInfo {
+	info := SMTPEmailInfo{
+		ID:        raw.ID,
+		Sender:    raw.Sender,
+		Recipient: raw.Recipient,
+		Subject:   raw.Subject,
+		Opened:    toInt(raw.Tracking.Open),
+		Clicked:   toInt(raw.Tracking.Click),
+	}
+	if raw.Status != nil {
+		info.Status = fmt.Sprint(raw.Status)
+	}
+	if sendDate, err := time.Parse(sendDateLayout, raw.SendDate); err == nil {
+		info.SendDate = sendDate
+	}
+	return info
+}
+
+func (s *smtp) Send(msg SMTPEmail) (*SMTPSendResult, error) {
+	return s.SendContext(context.Background(), msg)
+}
+
+func (s *smtp) SendContext(ctx context.Context, msg SMTPEmail) (*SMTPSendResult, error) {
+	if len(msg.To) == 0 {
+		return nil, ErrNoToRecipients
+	}
+	for _, attachment := range msg.InlineAttachments {
+		if attachment.ContentID == "" {
+			return nil, ErrEmptyContentID
+		}
+	}
+
+	email := smtpEmailRaw{
+		Text:    msg.Text,
+		Subject: msg.Subject,
+		From:    msg.From,
+		To:      msg.To,
+		Cc:      msg.Cc,
+		Bcc:     msg.Bcc,
+	}
+	if msg.HTML != "" {
+		email.HTML = b64.StdEncoding.EncodeToString([]byte(msg.HTML))
+	}
+	if len(msg.Attachments) != 0 {
+		email.AttachmentsBinary = make(map[string]string)
+		for _, attachment := range msg.Attachments {
+			email.AttachmentsBinary[attachment.Filename] = b64.StdEncoding.EncodeToString(attachment.Content)
+		}
+	}
+	for _, attachment := range msg.InlineAttachments {
+		email.InlineAttachments = append(email.InlineAttachments, inlineAttachmentRaw{
+			Filename:    attachment.Filename,
+			ContentID:   attachment.ContentID,
+			Content:     b64.StdEncoding.EncodeToString(attachment.Content),
+			Disposition: "inline",
+		})
+	}
+
+	if msg.IdempotencyKey == "" {
+		return s.send(ctx, email)
+	}
+
+	encoded, err := json.Marshal(email)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.Client.sent.do(ctx, idempotencyKey(msg.IdempotencyKey, encoded), func() (interface{}, error) {
+		return s.send(ctx, email)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SMTPSendResult), nil
+}
+
+// SendByTemplate sends a transactional email rendered by SendPulse from a stored template (see Emails.Templates).
+// Unlike campaigns, it's delivered right away to the given recipients only, not to an address book.
+// The variables are shared by all the recipients: the API has no per-recipient overrides,
+// so send separate emails if they need different values
+func (s *smtp) SendByTemplate(templateID string, to []Recipient, variables map[string]interface{}, subject string, fromName string, fromEmail string) (*SMTPSendResult, error) {
+	return s.SendByTemplateContext(context.Background(), templateID, to, variables, subject, fromName, fromEmail)
+}
+
+func (s *smtp) SendByTemplateContext(ctx context.Context, templateID string, to []Recipient, variables map[string]interface{}, subject string, fromName string, fromEmail string) (*SMTPSendResult, error) {
+	if variables == nil {
+		variables = make(map[string]interface{})
+	}
+
+	// Template ids are numeric, but they are kept as strings in Emails.Templates
+	var id interface{} = templateID
+	if numericID, err := strconv.Atoi(templateID); err == nil {
+		id = numericID
+	}
+
+	return s.send(ctx, smtpTemplateEmailRaw{
+		Subject: subject,
+		From:    Recipient{Name: fromName, Email: fromEmail},
+		To:      to,
+		Template: smtpTemplateRaw{
+			ID:        id,
+			Variables: variables,
+		},
+	})
+}
+
+func (s *smtp) send(ctx context.Context, email interface{}) (*SMTPSendResult, error) {
+	path := "/smtp/emails"
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"email": email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Client.checkResult("POST", path, body); err != nil {
+		return nil, err
+	}
+
+	var respData struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("POST", path, body, err.Error())
+	}
+
+	return &SMTPSendResult{ID: respData.ID}, nil
+}
+
+func (s *smtp) List(params SMTPListParams) ([]SMTPEmailInfo, error) {
+	return s.ListContext(context.Background(), params)
+}
+
+func (s *smtp) ListContext(ctx context.Context, params SMTPListParams) ([]SMTPEmailInfo, error) {
+	path := "/smtp/emails"
+
+	if !params.From.IsZero() && !params.To.IsZero() && params.From.After(params.To) {
+		return nil, ErrInvalidDateRange
+	}
+
+	data := make(map[string]interface{})
+	if params.Limit != 0 {
+		data["limit"] = params.Limit
+	}
+	if params.Offset != 0 {
+		data["offset"] = params.Offset
+	}
+	if !params.From.IsZero() {
+		data["from"] = params.From.Format("2006-01-02")
+	}
+	if !params.To.IsZero() {
+		data["to"] = params.To.Format("2006-01-02")
+	}
+	if params.Sender != "" {
+		data["sender"] = params.Sender
+	}
+	if params.Recipient != "" {
+		data["recipient"] = params.Recipient
+	}
+	if params.Status != "" {
+		data["status"] = params.Status
+	}
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make([]SMTPEmailInfo, 0)
+	if isEmptyCollection(body) {
+		return emails, nil
+	}
+
+	var respData []smtpEmailInfoRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range respData {
+		emails = append(emails, raw.emailInfo())
+	}
+	return emails, nil
+}
+
+func (s *smtp) Get(emailID string) (*SMTPEmailInfo, error) {
+	return s.GetContext(context.Background(), emailID)
+}
+
+func (s *smtp) GetContext(ctx context.Context, emailID string) (*SMTPEmailInfo, error) {
+	path := fmt.Sprintf("/smtp/emails/%s", url.PathEscape(emailID))
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw smtpEmailInfoRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	info := raw.emailInfo()
+	return &info, nil
+}
+
+type SMTPUnsubscribe struct {
+	Email   string
+	Comment string
+	Date    time.Time
+}
+
+type SMTPUnsubscribeEntry struct {
+	Email   string `json:"email"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type smtpUnsubscribeRaw struct {
+	Email   string `json:"email"`
+	Comment string `json:"comment"`
+	Date    string `json:"date"`
+}
+
+// Unsubscribed returns the emails unsubscribed from transactional emails. They are kept apart from address books
+func (s *smtp) Unsubscribed(limit int, offset int) ([]SMTPUnsubscribe, error) {
+	return s.UnsubscribedContext(context.Background(), limit, offset)
+}
+
+func (s *smtp) UnsubscribedContext(ctx context.Context, limit int, offset int) ([]SMTPUnsubscribe, error) {
+	path := "/smtp/unsubscribe"
+	data := map[string]interface{}{
+		"limit":  fmt.Sprint(limit),
+		"offset": fmt.Sprint(offset),
+	}
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	unsubscribed := make([]SMTPUnsubscribe, 0)
+	if isEmptyCollection(body) {
+		return unsubscribed, nil
+	}
+
+	var respData []smtpUnsubscribeRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range respData {
+		entry := SMTPUnsubscribe{Email: raw.Email, Comment: raw.Comment}
+		if date, err := time.Parse(sendDateLayout, raw.Date); err == nil {
+			entry.Date = date
+		}
+		unsubscribed = append(unsubscribed, entry)
+	}
+	return unsubscribed, nil
+}
+
+func (s *smtp) AddToUnsubscribe(entries []SMTPUnsubscribeEntry) error {
+	return s.AddToUnsubscribeContext(context.Background(), entries)
+}
+
+func (s *smtp) AddToUnsubscribeContext(ctx context.Context, entries []SMTPUnsubscribeEntry) error {
+	path := "/smtp/unsubscribe"
+
+	if len(entries) == 0 {
+		return ErrEmptyEmails
+	}
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"emails": entries,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("POST", path, body)
+}
+
+func (s *smtp) RemoveFromUnsubscribe(emails []string) error {
+	return s.RemoveFromUnsubscribeContext(context.Background(), emails)
+}
+
+func (s *smtp) RemoveFromUnsubscribeContext(ctx context.Context, emails []string) error {
+	path := "/smtp/unsubscribe"
+
+	if len(emails) == 0 {
+		return ErrEmptyEmails
+	}
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "DELETE", map[string]interface{}{
+		"emails": emails,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("DELETE", path, body)
+}
+
+// Webhook actions SendPulse can notify about
+const (
+	WebhookActionDelivery    = "delivery"
+	WebhookActionOpen        = "open"
+	WebhookActionClick       = "click"
+	WebhookActionUnsubscribe = "unsubscribe"
+	WebhookActionSpam        = "spam"
+	WebhookActionHardBounce  = "hard_bounce"
+	WebhookActionSoftBounce  = "soft_bounce"
+)
+
+var webhookActions = map[string]bool{
+	WebhookActionDelivery:    true,
+	WebhookActionOpen:        true,
+	WebhookActionClick:       true,
+	WebhookActionUnsubscribe: true,
+	WebhookActionSpam:        true,
+	WebhookActionHardBounce:  true,
+	WebhookActionSoftBounce:  true,
+}
+
+// UnsupportedWebhookActionError is returned without calling the API when the action isn't one of WebhookAction* constants
+type UnsupportedWebhookActionError struct {
+	Action string
+}
+
+func (e *UnsupportedWebhookActionError) Error() string {
+	return fmt.Sprintf("unsupported webhook action: %q", e.Action)
+}
+
+type Webhook struct {
+	ID     int
+	Action string
+	Url    string
+}
+
+type webhookRaw struct {
+	ID     interface{} `json:"id"`
+	Action string      `json:"action"`
+	Url    string      `json:"url"`
+}
+
+func (s *smtp) Webhooks() ([]Webhook, error) {
+	return s.WebhooksContext(context.Background())
+}
+
+func (s *smtp) WebhooksContext(ctx context.Context) ([]Webhook, error) {
+	path := "/v2/email-service/webhook"
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decodeWebhooks("GET", path, body)
+}
+
+// CreateWebhook subscribes the url to every action and returns the created webhooks, one per action
+func (s *smtp) CreateWebhook(actions []string, webhookUrl string) ([]Webhook, error) {
+	return s.CreateWebhookContext(context.Background(), actions, webhookUrl)
+}
+
+func (s *smtp) CreateWebhookContext(ctx context.Context, actions []string, webhookUrl string) ([]Webhook, error) {
+	path := "/v2/email-service/webhook"
+
+	if len(actions) == 0 {
+		return nil, errors.New("no webhook actions")
+	}
+	for _, action := range actions {
+		if !webhookActions[action] {
+			return nil, &UnsupportedWebhookActionError{Action: action}
+		}
+	}
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"url":     webhookUrl,
+		"actions": actions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decodeWebhooks("POST", path, body)
+}
+
+func (s *smtp) UpdateWebhook(webhookID int, webhookUrl string) error {
+	return s.UpdateWebhookContext(context.Background(), webhookID, webhookUrl)
+}
+
+func (s *smtp) UpdateWebhookContext(ctx context.Context, webhookID int, webhookUrl string) error {
+	path := fmt.Sprintf("/v2/email-service/webhook/%d", webhookID)
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "PUT", map[string]interface{}{
+		"url": webhookUrl,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkSuccess("PUT", path, body)
+}
+
+func (s *smtp) DeleteWebhook(webhookID int) error {
+	return s.DeleteWebhookContext(context.Background(), webhookID)
+}
+
+func (s *smtp) DeleteWebhookContext(ctx context.Context, webhookID int) error {
+	path := fmt.Sprintf("/v2/email-service/webhook/%d", webhookID)
+
+	body, err := s.Client.makeRequest(ctx, path, "DELETE", nil, true)
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkSuccess("DELETE", path, body)
+}
+
+func (s *smtp) decodeWebhooks(method string, path string, body []byte) ([]Webhook, error) {
+	if err := s.Client.checkSuccess(method, path, body); err != nil {
+		return nil, err
+	}
+
+	var respData struct {
+		Data []webhookRaw `json:"data"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse(method, path, body, err.Error())
+	}
+
+	webhooks := make([]Webhook, 0, len(respData.Data))
+	for _, raw := range respData.Data {
+		webhooks = append(webhooks, Webhook{ID: toInt(raw.ID), Action: raw.Action, Url: raw.Url})
+	}
+	return webhooks, nil
+}
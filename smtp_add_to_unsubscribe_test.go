@@ -0,0 +1,54 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSMTP_AddToUnsubscribe_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/smtp/unsubscribe",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.SMTP.AddToUnsubscribe([]SMTPUnsubscribeEntry{
+		{Email: "alice@example.com", Comment: "Asked via support"},
+		{Email: "bob@example.com"},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"emails": [{"email": "alice@example.com", "comment": "Asked via support"}, {"email": "bob@example.com"}]}`, requestBody)
+}
+
+func TestSMTP_AddToUnsubscribe_Empty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	assert.Equal(t, ErrEmptyEmails, spClient.SMTP.AddToUnsubscribe(nil))
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,180 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// BounceType tells whether delivery failed permanently (hard) or temporarily (soft)
+type BounceType string
+
+const (
+	BounceHard BounceType = "hard"
+	BounceSoft BounceType = "soft"
+)
+
+// BounceReason is the cause of a bounce. Reasons the SDK doesn't know are BounceReasonOther,
+// with the text reported by the receiving server kept in BounceRecord.Explain
+type BounceReason string
+
+const (
+	BounceReasonUnknownUser    BounceReason = "unknown_user"
+	BounceReasonMailboxFull    BounceReason = "mailbox_full"
+	BounceReasonDomainNotFound BounceReason = "domain_not_found"
+	BounceReasonBlocked        BounceReason = "blocked"
+	BounceReasonSpam           BounceReason = "spam"
+	BounceReasonOther          BounceReason = "other"
+)
+
+var bounceReasons = map[string]BounceReason{
+	string(BounceReasonUnknownUser):    BounceReasonUnknownUser,
+	string(BounceReasonMailboxFull):    BounceReasonMailboxFull,
+	string(BounceReasonDomainNotFound): BounceReasonDomainNotFound,
+	string(BounceReasonBlocked):        BounceReasonBlocked,
+	string(BounceReasonSpam):           BounceReasonSpam,
+}
+
+// BounceRecord is a transactional email that couldn't be delivered
+type BounceRecord struct {
+	Email    string
+	Sender   string
+	Type     BounceType
+	Reason   BounceReason
+	SMTPCode int
+	Explain  string
+	Date     time.Time
+}
+
+// ComplaintRecord is a spam complaint about a transactional email
+type ComplaintRecord struct {
+	Email   string
+	Sender  string
+	Subject string
+	Date    time.Time
+}
+
+type bounceRecordRaw struct {
+	Email    string      `json:"email_to"`
+	Sender   string      `json:"sender"`
+	Type     string      `json:"bounce_type"`
+	Reason   string      `json:"bounce_reason"`
+	SMTPCode interface{} `json:"smtp_answer_code"`
+	Explain  string      `json:"smtp_answer_data"`
+	Date     string      `json:"send_date"`
+}
+
+func (raw bounceRecordRaw) bounceRecord() BounceRecord {
+	record := BounceRecord{
+		Email:    raw.Email,
+		Sender:   raw.Sender,
+		Type:     BounceType(strings.ToLower(raw.Type)),
+		Reason:   BounceReasonOther,
+		SMTPCode: toInt(raw.SMTPCode),
+		Explain:  raw.Explain,
+	}
+	if reason, ok := bounceReasons[strings.ToLower(raw.Reason)]; ok {
+		record.Reason = reason
+	}
+	// Without an explicit type a 4xx answer is a temporary failure and anything else a permanent one
+	if record.Type != BounceHard && record.Type != BounceSoft {
+		record.Type = BounceHard
+		if record.SMTPCode >= 400 && record.SMTPCode < 500 {
+			record.Type = BounceSoft
+		}
+	}
+	if date, err := time.Parse(sendDateLayout, raw.Date); err == nil {
+		record.Date = date
+	}
+	return record
+}
+
+type complaintRecordRaw struct {
+	Email   string `json:"email_to"`
+	Sender  string `json:"sender"`
+	Subject string `json:"subject"`
+	Date    string `json:"date"`
+}
+
+// Bounces returns the bounced transactional emails. Zero dateFrom or dateTo mean no limit on that side
+func (s *smtp) Bounces(dateFrom time.Time, dateTo time.Time, limit int, offset int) ([]BounceRecord, error) {
+	return s.BouncesContext(context.Background(), dateFrom, dateTo, limit, offset)
+}
+
+func (s *smtp) BouncesContext(ctx context.Context, dateFrom time.Time, dateTo time.Time, limit int, offset int) ([]BounceRecord, error) {
+	path := "/smtp/bounces"
+
+	body, err := s.feed(ctx, path, dateFrom, dateTo, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	bounces := make([]BounceRecord, 0)
+	if isEmptyCollection(body) {
+		return bounces, nil
+	}
+
+	var respData []bounceRecordRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range respData {
+		bounces = append(bounces, raw.bounceRecord())
+	}
+	return bounces, nil
+}
+
+// Complaints returns the spam complaints about transactional emails. Zero dateFrom or dateTo mean no limit on that side
+func (s *smtp) Complaints(dateFrom time.Time, dateTo time.Time, limit int, offset int) ([]ComplaintRecord, error) {
+	return s.ComplaintsContext(context.Background(), dateFrom, dateTo, limit, offset)
+}
+
+func (s *smtp) ComplaintsContext(ctx context.Context, dateFrom time.Time, dateTo time.Time, limit int, offset int) ([]ComplaintRecord, error) {
+	path := "/smtp/complaints"
+
+	body, err := s.feed(ctx, path, dateFrom, dateTo, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	complaints := make([]ComplaintRecord, 0)
+	if isEmptyCollection(body) {
+		return complaints, nil
+	}
+
+	var respData []complaintRecordRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range respData {
+		complaint := ComplaintRecord{Email: raw.Email, Sender: raw.Sender, Subject: raw.Subject}
+		if date, err := time.Parse(sendDateLayout, raw.Date); err == nil {
+			complaint.Date = date
+		}
+		complaints = append(complaints, complaint)
+	}
+	return complaints, nil
+}
+
+// feed requests a page of a date filtered SMTP report
+func (s *smtp) feed(ctx context.Context, path string, dateFrom time.Time, dateTo time.Time, limit int, offset int) ([]byte, error) {
+	if !dateFrom.IsZero() && !dateTo.IsZero() && dateFrom.After(dateTo) {
+		return nil, ErrInvalidDateRange
+	}
+
+	data := map[string]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	}
+	if !dateFrom.IsZero() {
+		data["from"] = dateFrom.Format("2006-01-02")
+	}
+	if !dateTo.IsZero() {
+		data["to"] = dateTo.Format("2006-01-02")
+	}
+
+	return s.Client.makeRequest(ctx, path, "GET", data, true)
+}
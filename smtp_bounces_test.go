@@ -0,0 +1,94 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSMTP_Bounces(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/smtp/bounces?from=2026-10-01&limit=10&offset=0&to=2026-10-07",
+		httpmock.NewStringResponder(http.StatusOK, `[
+			{
+				"email_to": "nobody@example.com",
+				"sender": "shop@example.com",
+				"bounce_type": "hard",
+				"bounce_reason": "unknown_user",
+				"smtp_answer_code": 550,
+				"smtp_answer_data": "5.1.1 User unknown",
+				"send_date": "2026-10-02 11:20:00"
+			},
+			{
+				"email_to": "full@example.com",
+				"sender": "shop@example.com",
+				"bounce_reason": "quota_exceeded_temporarily",
+				"smtp_answer_code": "452",
+				"smtp_answer_data": "4.2.2 Mailbox full",
+				"send_date": "2026-10-03 08:05:30"
+			}
+		]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	bounces, err := spClient.SMTP.Bounces(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 7, 0, 0, 0, 0, time.UTC), 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []BounceRecord{
+		{
+			Email:    "nobody@example.com",
+			Sender:   "shop@example.com",
+			Type:     BounceHard,
+			Reason:   BounceReasonUnknownUser,
+			SMTPCode: 550,
+			Explain:  "5.1.1 User unknown",
+			Date:     time.Date(2026, 10, 2, 11, 20, 0, 0, time.UTC),
+		},
+		{
+			Email:    "full@example.com",
+			Sender:   "shop@example.com",
+			Type:     BounceSoft,
+			Reason:   BounceReasonOther,
+			SMTPCode: 452,
+			Explain:  "4.2.2 Mailbox full",
+			Date:     time.Date(2026, 10, 3, 8, 5, 30, 0, time.UTC),
+		},
+	}, bounces)
+}
+
+func TestSMTP_Bounces_InvalidDateRange(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.SMTP.Bounces(time.Date(2026, 10, 7, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), 10, 0)
+	assert.Equal(t, ErrInvalidDateRange, err)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestSMTP_Complaints(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/smtp/complaints?limit=10&offset=20",
+		httpmock.NewStringResponder(http.StatusOK, `[{"email_to": "angry@example.com", "sender": "shop@example.com", "subject": "Sale", "date": "2026-10-04 16:00:00"}]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	complaints, err := spClient.SMTP.Complaints(time.Time{}, time.Time{}, 10, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, []ComplaintRecord{{
+		Email:   "angry@example.com",
+		Sender:  "shop@example.com",
+		Subject: "Sale",
+		Date:    time.Date(2026, 10, 4, 16, 0, 0, 0, time.UTC),
+	}}, complaints)
+}
@@ -0,0 +1,54 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSMTP_CreateWebhook_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/v2/email-service/webhook",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"success": true, "data": [
+				{"id": 21, "url": "https://example.com/hooks", "action": "delivery"},
+				{"id": 22, "url": "https://example.com/hooks", "action": "spam"}
+			]}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	webhooks, err := spClient.SMTP.CreateWebhook([]string{WebhookActionDelivery, WebhookActionSpam}, "https://example.com/hooks")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"url": "https://example.com/hooks", "actions": ["delivery", "spam"]}`, requestBody)
+	assert.Equal(t, []Webhook{
+		{ID: 21, Action: WebhookActionDelivery, Url: "https://example.com/hooks"},
+		{ID: 22, Action: WebhookActionSpam, Url: "https://example.com/hooks"},
+	}, webhooks)
+}
+
+func TestSMTP_CreateWebhook_UnsupportedAction(t *testing.T) {
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+
+	_, err := spClient.SMTP.CreateWebhook([]string{WebhookActionOpen, "opened"}, "https://example.com/hooks")
+	assert.Equal(t, &UnsupportedWebhookActionError{Action: "opened"}, err)
+}
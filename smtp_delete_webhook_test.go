@@ -0,0 +1,45 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestSMTP_DeleteWebhook_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("DELETE", apiBaseUrl+"/v2/email-service/webhook/21",
+		httpmock.NewStringResponder(http.StatusOK, `{"success": true, "data": [true]}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	assert.NoError(t, spClient.SMTP.DeleteWebhook(21))
+}
+
+func TestSMTP_DeleteWebhook_Failed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("DELETE", apiBaseUrl+"/v2/email-service/webhook/21",
+		httpmock.NewStringResponder(http.StatusOK, `{"success": false}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	assert.Error(t, spClient.SMTP.DeleteWebhook(21))
+}
@@ -0,0 +1,152 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ErrDomainNotFound is returned when the domain isn't added to the account's sending domains
+var ErrDomainNotFound = errors.New("smtp domain not found")
+
+// SMTPDomain is a sending domain with the state of its DNS records
+type SMTPDomain struct {
+	Domain    string
+	Status    string
+	DKIMValid bool
+	SPFValid  bool
+}
+
+// DNSRecordCheck is the state of a DNS record of a sending domain. Expected is the value the record must have
+type DNSRecordCheck struct {
+	Valid    bool
+	Expected string
+}
+
+// DomainVerification is the result of checking the DNS records of a sending domain.
+// Missing lists the records to fix ("dkim", "spf") and is empty when the domain is verified
+type DomainVerification struct {
+	Domain   string
+	Verified bool
+	DKIM     DNSRecordCheck
+	SPF      DNSRecordCheck
+	Missing  []string
+}
+
+type dnsRecordCheckRaw struct {
+	Valid  interface{} `json:"valid"`
+	Record string      `json:"record"`
+}
+
+func (raw dnsRecordCheckRaw) check() DNSRecordCheck {
+	check := DNSRecordCheck{Expected: raw.Record}
+	// The flag may come as a boolean or a number
+	if valid, ok := raw.Valid.(bool); ok {
+		check.Valid = valid
+	} else {
+		check.Valid = toInt(raw.Valid) != 0
+	}
+	return check
+}
+
+type smtpDomainRaw struct {
+	Domain string            `json:"domain"`
+	Status string            `json:"status"`
+	DKIM   dnsRecordCheckRaw `json:"dkim"`
+	SPF    dnsRecordCheckRaw `json:"spf"`
+}
+
+func (s *smtp) Domains() ([]SMTPDomain, error) {
+	return s.DomainsContext(context.Background())
+}
+
+func (s *smtp) DomainsContext(ctx context.Context) ([]SMTPDomain, error) {
+	path := "/smtp/domains"
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	domains := make([]SMTPDomain, 0)
+	if isEmptyCollection(body) {
+		return domains, nil
+	}
+
+	var respData []smtpDomainRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range respData {
+		domains = append(domains, SMTPDomain{
+			Domain:    raw.Domain,
+			Status:    raw.Status,
+			DKIMValid: raw.DKIM.check().Valid,
+			SPFValid:  raw.SPF.check().Valid,
+		})
+	}
+	return domains, nil
+}
+
+// AddDomain adds a sending domain. Its DNS records are then checked with VerifyDomain
+func (s *smtp) AddDomain(domain string) error {
+	return s.AddDomainContext(context.Background(), domain)
+}
+
+func (s *smtp) AddDomainContext(ctx context.Context, domain string) error {
+	path := "/smtp/domains"
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"domain": domain,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("POST", path, body)
+}
+
+// VerifyDomain checks the DKIM and SPF records of the sending domain and reports what has to be fixed
+func (s *smtp) VerifyDomain(domain string) (*DomainVerification, error) {
+	return s.VerifyDomainContext(context.Background(), domain)
+}
+
+func (s *smtp) VerifyDomainContext(ctx context.Context, domain string) (*DomainVerification, error) {
+	path := fmt.Sprintf("/smtp/domains/%s/verify", url.PathEscape(domain))
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		if spErr, ok := err.(*SendpulseError); ok && spErr.HttpCode == http.StatusNotFound {
+			return nil, ErrDomainNotFound
+		}
+		return nil, err
+	}
+
+	var raw smtpDomainRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	verification := DomainVerification{
+		Domain:  raw.Domain,
+		DKIM:    raw.DKIM.check(),
+		SPF:     raw.SPF.check(),
+		Missing: make([]string, 0),
+	}
+	if verification.Domain == "" {
+		verification.Domain = domain
+	}
+	if !verification.DKIM.Valid {
+		verification.Missing = append(verification.Missing, "dkim")
+	}
+	if !verification.SPF.Valid {
+		verification.Missing = append(verification.Missing, "spf")
+	}
+	verification.Verified = len(verification.Missing) == 0
+
+	return &verification, nil
+}
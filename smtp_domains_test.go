@@ -0,0 +1,107 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSMTP_Domains(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/smtp/domains",
+		httpmock.NewStringResponder(http.StatusOK, `[
+			{"domain": "example.com", "status": "verified", "dkim": {"valid": true}, "spf": {"valid": 1}},
+			{"domain": "shop.example.com", "status": "pending", "dkim": {"valid": false}, "spf": {"valid": true}}
+		]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	domains, err := spClient.SMTP.Domains()
+	assert.NoError(t, err)
+	assert.Equal(t, []SMTPDomain{
+		{Domain: "example.com", Status: "verified", DKIMValid: true, SPFValid: true},
+		{Domain: "shop.example.com", Status: "pending", DKIMValid: false, SPFValid: true},
+	}, domains)
+}
+
+func TestSMTP_AddDomain(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/smtp/domains",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	assert.NoError(t, spClient.SMTP.AddDomain("example.com"))
+	assert.JSONEq(t, `{"domain": "example.com"}`, requestBody)
+}
+
+func TestSMTP_VerifyDomain_Verified(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/smtp/domains/example.com/verify",
+		httpmock.NewStringResponder(http.StatusOK, `{"domain": "example.com", "dkim": {"valid": true}, "spf": {"valid": true}}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	verification, err := spClient.SMTP.VerifyDomain("example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, DomainVerification{
+		Domain:   "example.com",
+		Verified: true,
+		DKIM:     DNSRecordCheck{Valid: true},
+		SPF:      DNSRecordCheck{Valid: true},
+		Missing:  []string{},
+	}, *verification)
+}
+
+func TestSMTP_VerifyDomain_PartiallyConfigured(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/smtp/domains/example.com/verify",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"domain": "example.com",
+			"dkim": {"valid": true},
+			"spf": {"valid": false, "record": "v=spf1 include:mxsmtp.sendpulse.com ~all"}
+		}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	verification, err := spClient.SMTP.VerifyDomain("example.com")
+	assert.NoError(t, err)
+	assert.False(t, verification.Verified)
+	assert.Equal(t, []string{"spf"}, verification.Missing)
+	assert.Equal(t, "v=spf1 include:mxsmtp.sendpulse.com ~all", verification.SPF.Expected)
+}
+
+func TestSMTP_VerifyDomain_NotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/smtp/domains/unknown.com/verify",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"error_code": 404, "message": "Domain not found"}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	verification, err := spClient.SMTP.VerifyDomain("unknown.com")
+	assert.Nil(t, verification)
+	assert.Equal(t, ErrDomainNotFound, err)
+}
@@ -0,0 +1,53 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestSMTP_Get_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/smtp/emails/y0m2vb-0bf1nz-ny",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": "y0m2vb-0bf1nz-ny", "sender": "shop@example.com", "recipient": "alice@example.com", "subject": "Your order", "send_date": "2030-01-02 07:30:00", "tracking": {"click": 0, "open": 1}}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	info, err := spClient.SMTP.Get("y0m2vb-0bf1nz-ny")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice@example.com", info.Recipient)
+	assert.Equal(t, 1, info.Opened)
+	assert.Equal(t, 0, info.Clicked)
+	assert.Equal(t, 2030, info.SendDate.Year())
+}
+
+func TestSMTP_Get_BadJson(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/smtp/emails/1",
+		httpmock.NewStringResponder(http.StatusOK, `Invalid json`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	info, err := spClient.SMTP.Get("1")
+	assert.Nil(t, info)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
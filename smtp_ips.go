@@ -0,0 +1,87 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// ErrInvalidIP is returned without calling the API when the address isn't a valid IPv4 or IPv6 one
+var ErrInvalidIP = errors.New("invalid ip address")
+
+// AllowedIPs returns the dedicated IPs of the account. It's empty when there are none
+func (s *smtp) AllowedIPs() ([]string, error) {
+	return s.AllowedIPsContext(context.Background())
+}
+
+func (s *smtp) AllowedIPsContext(ctx context.Context) ([]string, error) {
+	path := "/smtp/ips"
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ips := make([]string, 0)
+	if isEmptyCollection(body) {
+		return ips, nil
+	}
+
+	// Items are either plain addresses or objects with an "ip" field
+	var respData []interface{}
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, item := range respData {
+		if object, ok := item.(map[string]interface{}); ok {
+			item = object["ip"]
+		}
+		if ip := toString(item); ip != "" {
+			ips = append(ips, ip)
+		}
+	}
+	return ips, nil
+}
+
+func (s *smtp) AddAllowedIP(ip string) error {
+	return s.AddAllowedIPContext(context.Background(), ip)
+}
+
+func (s *smtp) AddAllowedIPContext(ctx context.Context, ip string) error {
+	if net.ParseIP(ip) == nil {
+		return ErrInvalidIP
+	}
+
+	path := "/smtp/ips"
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "POST", map[string]interface{}{
+		"ip": ip,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("POST", path, body)
+}
+
+func (s *smtp) RemoveAllowedIP(ip string) error {
+	return s.RemoveAllowedIPContext(context.Background(), ip)
+}
+
+func (s *smtp) RemoveAllowedIPContext(ctx context.Context, ip string) error {
+	if net.ParseIP(ip) == nil {
+		return ErrInvalidIP
+	}
+
+	path := fmt.Sprintf("/smtp/ips/%s", ip)
+
+	body, err := s.Client.makeRequest(ctx, path, "DELETE", nil, true)
+	if err != nil {
+		return err
+	}
+
+	return s.Client.checkResult("DELETE", path, body)
+}
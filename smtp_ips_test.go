@@ -0,0 +1,75 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSMTP_AllowedIPs(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/smtp/ips",
+		httpmock.NewStringResponder(http.StatusOK, `["178.32.120.5", {"ip": "2001:db8::1"}]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	ips, err := spClient.SMTP.AllowedIPs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"178.32.120.5", "2001:db8::1"}, ips)
+}
+
+func TestSMTP_AllowedIPs_Empty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/smtp/ips",
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	ips, err := spClient.SMTP.AllowedIPs()
+	assert.NoError(t, err)
+	assert.NotNil(t, ips)
+	assert.Equal(t, 0, len(ips))
+}
+
+func TestSMTP_AddAllowedIP(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/smtp/ips",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+	httpmock.RegisterResponder("DELETE", apiBaseUrl+"/smtp/ips/178.32.120.5",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	assert.NoError(t, spClient.SMTP.AddAllowedIP("178.32.120.5"))
+	assert.JSONEq(t, `{"ip": "178.32.120.5"}`, requestBody)
+	assert.NoError(t, spClient.SMTP.RemoveAllowedIP("178.32.120.5"))
+}
+
+func TestSMTP_AllowedIP_Invalid(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	assert.Equal(t, ErrInvalidIP, spClient.SMTP.AddAllowedIP("178.32.120"))
+	assert.Equal(t, ErrInvalidIP, spClient.SMTP.RemoveAllowedIP("localhost"))
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,100 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestSMTP_List_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var query url.Values
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/smtp/emails",
+		func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			return httpmock.NewStringResponse(http.StatusOK, `[{
+				"id": "y0m2vb-0bf1nz-ny",
+				"sender": "shop@example.com",
+				"recipient": "alice@example.com",
+				"subject": "Your order",
+				"status": "Delivered",
+				"send_date": "2030-01-02 07:30:00",
+				"tracking": {"click": "1", "open": 2}
+			}]`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	emails, err := spClient.SMTP.List(SMTPListParams{
+		Limit:     10,
+		From:      time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:        time.Date(2030, 1, 31, 0, 0, 0, 0, time.UTC),
+		Recipient: "alice@example.com",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []SMTPEmailInfo{{
+		ID:        "y0m2vb-0bf1nz-ny",
+		Sender:    "shop@example.com",
+		Recipient: "alice@example.com",
+		Subject:   "Your order",
+		Status:    "Delivered",
+		SendDate:  time.Date(2030, 1, 2, 7, 30, 0, 0, time.UTC),
+		Opened:    2,
+		Clicked:   1,
+	}}, emails)
+
+	assert.Equal(t, url.Values{
+		"limit":     {"10"},
+		"from":      {"2030-01-01"},
+		"to":        {"2030-01-31"},
+		"recipient": {"alice@example.com"},
+	}, query)
+}
+
+func TestSMTP_List_Empty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/smtp/emails",
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	emails, err := spClient.SMTP.List(SMTPListParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, []SMTPEmailInfo{}, emails)
+}
+
+func TestSMTP_List_InvalidDateRange(t *testing.T) {
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+
+	emails, err := spClient.SMTP.List(SMTPListParams{
+		From: time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	assert.Nil(t, emails)
+	assert.Equal(t, ErrInvalidDateRange, err)
+}
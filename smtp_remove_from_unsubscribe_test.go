@@ -0,0 +1,46 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSMTP_RemoveFromUnsubscribe_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("DELETE", apiBaseUrl+"/smtp/unsubscribe",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	err := spClient.SMTP.RemoveFromUnsubscribe([]string{"alice@example.com", "bob@example.com"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"emails": ["alice@example.com", "bob@example.com"]}`, requestBody)
+}
+
+func TestSMTP_RemoveFromUnsubscribe_Empty(t *testing.T) {
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+
+	assert.Equal(t, ErrEmptyEmails, spClient.SMTP.RemoveFromUnsubscribe([]string{}))
+}
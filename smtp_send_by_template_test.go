@@ -0,0 +1,85 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSMTP_SendByTemplate_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/smtp/emails",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "id": "y0m2vb-0bf1nz-ny"}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	variables := map[string]interface{}{
+		"name":     "Alice",
+		"total":    10.5,
+		"items":    3,
+		"is_first": true,
+	}
+	result, err := spClient.SMTP.SendByTemplate("1234", []Recipient{{Name: "Alice", Email: "alice@example.com"}},
+		variables, "Your order", "Shop", "shop@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "y0m2vb-0bf1nz-ny", result.ID)
+	assert.JSONEq(t, `{
+		"email": {
+			"subject": "Your order",
+			"from": {"name": "Shop", "email": "shop@example.com"},
+			"to": [{"name": "Alice", "email": "alice@example.com"}],
+			"template": {
+				"id": 1234,
+				"variables": {"name": "Alice", "total": 10.5, "items": 3, "is_first": true}
+			}
+		}
+	}`, requestBody)
+}
+
+func TestSMTP_SendByTemplate_NoVariables(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/smtp/emails",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "id": "y0m2vb-0bf1nz-ny"}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.SMTP.SendByTemplate("1234", []Recipient{{Email: "alice@example.com"}}, nil, "Hi", "", "shop@example.com")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"email": {
+			"subject": "Hi",
+			"from": {"email": "shop@example.com"},
+			"to": [{"email": "alice@example.com"}],
+			"template": {"id": 1234, "variables": {}}
+		}
+	}`, requestBody)
+}
@@ -0,0 +1,246 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSMTP_Send_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/smtp/emails",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "id": "y0m2vb-0bf1nz-ny"}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.SMTP.Send(SMTPEmail{
+		From: Recipient{Name: "Shop", Email: "shop@example.com"},
+		To: []Recipient{
+			{Name: "Alice", Email: "alice@example.com"},
+			{Email: "bob@example.com"},
+		},
+		Subject:     "Your order",
+		HTML:        "<h1>Thanks</h1>",
+		Text:        "Thanks",
+		Attachments: []Attachment{{Filename: "invoice.txt", Content: []byte("total: 10")}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "y0m2vb-0bf1nz-ny", result.ID)
+	assert.JSONEq(t, `{
+		"email": {
+			"html": "PGgxPlRoYW5rczwvaDE+",
+			"text": "Thanks",
+			"subject": "Your order",
+			"from": {"name": "Shop", "email": "shop@example.com"},
+			"to": [{"name": "Alice", "email": "alice@example.com"}, {"email": "bob@example.com"}],
+			"attachments_binary": {"invoice.txt": "dG90YWw6IDEw"}
+		}
+	}`, requestBody)
+}
+
+func TestSMTP_Send_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/smtp/emails",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"error_code": 802, "message": "Sender is not valid"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.SMTP.Send(SMTPEmail{
+		From:    Recipient{Email: fake.EmailAddress()},
+		To:      []Recipient{{Email: fake.EmailAddress()}},
+		Subject: fake.Word(),
+		Text:    fake.Word(),
+	})
+	assert.Nil(t, result)
+	spErr, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+	assert.Equal(t, 802, spErr.ErrorCode)
+}
+
+func TestSMTP_Send_CcAndBcc(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/smtp/emails",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "id": "y0m2vb-0bf1nz-nz"}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.SMTP.Send(SMTPEmail{
+		From:    Recipient{Name: "Shop", Email: "shop@example.com"},
+		To:      []Recipient{{Name: "Alice", Email: "alice@example.com"}},
+		Cc:      []Recipient{{Name: "Accounting", Email: "accounting@example.com"}},
+		Bcc:     []Recipient{{Email: "archive@example.com"}},
+		Subject: "Your receipt",
+		Text:    "Thanks",
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"email": {
+			"text": "Thanks",
+			"subject": "Your receipt",
+			"from": {"name": "Shop", "email": "shop@example.com"},
+			"to": [{"name": "Alice", "email": "alice@example.com"}],
+			"cc": [{"name": "Accounting", "email": "accounting@example.com"}],
+			"bcc": [{"email": "archive@example.com"}]
+		}
+	}`, requestBody)
+}
+
+func TestSMTP_Send_NoRecipients(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.SMTP.Send(SMTPEmail{
+		From:    Recipient{Email: "shop@example.com"},
+		Bcc:     []Recipient{{Email: "archive@example.com"}},
+		Subject: "Your receipt",
+		Text:    "Thanks",
+	})
+	assert.Nil(t, result)
+	assert.Equal(t, ErrNoToRecipients, err)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestSMTP_Send_InlineAttachment(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/smtp/emails",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "id": "y0m2vb-0bf1nz-o0"}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.SMTP.Send(SMTPEmail{
+		From:              Recipient{Name: "Shop", Email: "shop@example.com"},
+		To:                []Recipient{{Email: "alice@example.com"}},
+		Subject:           "Your receipt",
+		HTML:              `<img src="cid:logo">`,
+		Attachments:       []Attachment{{Filename: "receipt.pdf", Content: []byte("%PDF")}},
+		InlineAttachments: []InlineAttachment{{Filename: "logo.png", ContentID: "logo", Content: []byte("PNG")}},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"email": {
+			"html": "PGltZyBzcmM9ImNpZDpsb2dvIj4=",
+			"subject": "Your receipt",
+			"from": {"name": "Shop", "email": "shop@example.com"},
+			"to": [{"email": "alice@example.com"}],
+			"attachments_binary": {"receipt.pdf": "JVBERg=="},
+			"inline_attachments_binary": [{"filename": "logo.png", "content_id": "logo", "content": "UE5H", "disposition": "inline"}]
+		}
+	}`, requestBody)
+}
+
+func TestSMTP_Send_InlineAttachmentWithoutContentID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.SMTP.Send(SMTPEmail{
+		From:              Recipient{Email: "shop@example.com"},
+		To:                []Recipient{{Email: "alice@example.com"}},
+		Subject:           "Your receipt",
+		HTML:              `<img src="cid:logo">`,
+		InlineAttachments: []InlineAttachment{{Filename: "logo.png", Content: []byte("PNG")}},
+	})
+	assert.Equal(t, ErrEmptyContentID, err)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestSMTP_Send_IdempotencyKey(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/smtp/emails",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": true, "id": "y0m2vb-0bf1nz-o1"}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	msg := SMTPEmail{
+		From:           Recipient{Email: "shop@example.com"},
+		To:             []Recipient{{Email: "alice@example.com"}},
+		Subject:        "Your receipt",
+		Text:           "Thanks",
+		IdempotencyKey: "order-1001",
+	}
+
+	first, err := spClient.SMTP.Send(msg)
+	assert.NoError(t, err)
+	second, err := spClient.SMTP.Send(msg)
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	msg.IdempotencyKey = "order-1002"
+	_, err = spClient.SMTP.Send(msg)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestSMTP_Send_IdempotencyKeyAfterError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/smtp/emails",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"error_code": 400, "message": "Invalid sender"}`))
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	msg := SMTPEmail{
+		From:           Recipient{Email: "shop@example.com"},
+		To:             []Recipient{{Email: "alice@example.com"}},
+		Subject:        "Your receipt",
+		Text:           "Thanks",
+		IdempotencyKey: "order-1001",
+	}
+
+	_, err := spClient.SMTP.Send(msg)
+	assert.Error(t, err)
+	_, err = spClient.SMTP.Send(msg)
+	assert.Error(t, err)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
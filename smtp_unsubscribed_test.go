@@ -0,0 +1,54 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSMTP_Unsubscribed_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/smtp/unsubscribe?limit=10&offset=0",
+		httpmock.NewStringResponder(http.StatusOK, `[{"email": "alice@example.com", "comment": "Asked via support", "date": "2030-01-02 07:30:00"}]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	unsubscribed, err := spClient.SMTP.Unsubscribed(10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []SMTPUnsubscribe{{
+		Email:   "alice@example.com",
+		Comment: "Asked via support",
+		Date:    time.Date(2030, 1, 2, 7, 30, 0, 0, time.UTC),
+	}}, unsubscribed)
+}
+
+func TestSMTP_Unsubscribed_Empty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/smtp/unsubscribe?limit=10&offset=0",
+		httpmock.NewStringResponder(http.StatusOK, `{}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	unsubscribed, err := spClient.SMTP.Unsubscribed(10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []SMTPUnsubscribe{}, unsubscribed)
+}
@@ -0,0 +1,56 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestSMTP_Webhooks_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/v2/email-service/webhook",
+		httpmock.NewStringResponder(http.StatusOK, `{"success": true, "data": [
+			{"id": 11, "user_id": 7, "url": "https://example.com/hooks", "action": "open", "created_at": "2021-05-01 10:00:00"},
+			{"id": 12, "user_id": 7, "url": "https://example.com/hooks", "action": "click", "created_at": "2021-05-01 10:00:00"}
+		]}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	webhooks, err := spClient.SMTP.Webhooks()
+	assert.NoError(t, err)
+	assert.Equal(t, []Webhook{
+		{ID: 11, Action: WebhookActionOpen, Url: "https://example.com/hooks"},
+		{ID: 12, Action: WebhookActionClick, Url: "https://example.com/hooks"},
+	}, webhooks)
+}
+
+func TestSMTP_Webhooks_Empty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/v2/email-service/webhook",
+		httpmock.NewStringResponder(http.StatusOK, `{"success": true, "data": []}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	webhooks, err := spClient.SMTP.Webhooks()
+	assert.NoError(t, err)
+	assert.NotNil(t, webhooks)
+	assert.Len(t, webhooks, 0)
+}
@@ -0,0 +1,12 @@
+package sendpulse
+
+import "time"
+
+// TokenStore keeps the access token outside of the client, e.g. in Redis, so it survives restarts
+// and is shared by several workers. The client consults it before requesting a new token.
+// Set with an empty token is called when SendPulse rejects the stored one.
+// Implementations must be safe for concurrent use.
+type TokenStore interface {
+	Get() (token string, expiry time.Time, ok bool)
+	Set(token string, expiry time.Time)
+}
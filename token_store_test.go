@@ -0,0 +1,116 @@
+package sendpulse
+
+import (
+	"context"
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testTokenStore struct {
+	lock   sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func (s *testTokenStore) Get() (string, time.Time, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.token, s.expiry, s.token != ""
+}
+
+func (s *testTokenStore) Set(token string, expiry time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.token = token
+	s.expiry = expiry
+}
+
+func TestTokenStore_Stored(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusOK,
+			`{"access_token": "testtoken","token_type": "Bearer","expires_in": 3600}`))
+
+	token := fake.Word()
+	store := &testTokenStore{token: token, expiry: time.Now().Add(time.Hour)}
+	config := Config{
+		UserID:     fake.Word(),
+		Secret:     fake.Word(),
+		Timeout:    5,
+		TokenStore: store,
+	}
+
+	c := NewClient(config)
+	result, err := c.getToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, token, result)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestTokenStore_Fetched(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	token := fake.Word()
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusOK,
+			`{"access_token": "`+token+`","token_type": "Bearer","expires_in": 3600}`))
+
+	store := &testTokenStore{token: fake.Word(), expiry: time.Now().Add(time.Second)}
+	config := Config{
+		UserID:     fake.Word(),
+		Secret:     fake.Word(),
+		Timeout:    5,
+		TokenStore: store,
+	}
+
+	c := NewClient(config)
+	result, err := c.getToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, token, result)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	storedToken, storedExpiry, ok := store.Get()
+	assert.True(t, ok)
+	assert.Equal(t, token, storedToken)
+	assert.True(t, storedExpiry.After(time.Now().Add(59*time.Minute)))
+}
+
+func TestTokenStore_Unauthorized(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	token := fake.Word()
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/oauth/access_token",
+		httpmock.NewStringResponder(http.StatusOK,
+			`{"access_token": "`+token+`","token_type": "Bearer","expires_in": 3600}`))
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/addressbooks",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Authorization") != "Bearer "+token {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, `Unauthorized`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `[]`), nil
+		})
+
+	store := &testTokenStore{token: fake.Word(), expiry: time.Now().Add(time.Hour)}
+	config := Config{
+		UserID:     fake.Word(),
+		Secret:     fake.Word(),
+		Timeout:    5,
+		TokenStore: store,
+	}
+
+	c := NewClient(config)
+	_, err := c.makeRequest(context.Background(), "/addressbooks", "GET", nil, true)
+	assert.NoError(t, err)
+
+	storedToken, _, _ := store.Get()
+	assert.Equal(t, token, storedToken)
+}
@@ -0,0 +1,338 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type viber struct {
+	Client *client
+}
+
+// ErrNoRecipients is returned without calling the API when a Viber campaign has neither phones nor an address book
+var ErrNoRecipients = errors.New("no recipients: neither phones nor address book are set")
+
+// ViberCampaignParams describes a Viber campaign. Recipients are either Phones or the phones of AddressBookID.
+// The button and the image are optional
+type ViberCampaignParams struct {
+	Name            string
+	SenderID        int
+	Phones          []string
+	AddressBookID   int
+	Message         string
+	MessageType     int
+	ButtonText      string
+	ButtonLink      string
+	ImageURL        string
+	MessageLiveTime int
+	SendDate        *time.Time
+}
+
+// ViberResult is the id of the sent campaign or message. Status is the delivery status of a single message,
+// empty when SendPulse doesn't report it
+type ViberResult struct {
+	ID     int
+	Status string
+}
+
+type ViberCampaign struct {
+	ID            int
+	Name          string
+	SenderName    string
+	Message       string
+	ButtonText    string
+	ButtonLink    string
+	ImageURL      string
+	AddressBookID int
+	Status        int
+	SendDate      time.Time
+	Created       time.Time
+}
+
+type ViberStats struct {
+	Sent        int
+	Delivered   int
+	Read        int
+	Clicked     int
+	Undelivered int
+}
+
+// ViberCampaignDetail is a Viber campaign with its delivery statistics
+type ViberCampaignDetail struct {
+	ViberCampaign
+	Stats ViberStats
+}
+
+type viberButtonRaw struct {
+	Text string `json:"text"`
+	Link string `json:"link"`
+}
+
+type viberImageRaw struct {
+	Link string `json:"link"`
+}
+
+type viberAdditionalRaw struct {
+	Button *viberButtonRaw `json:"button,omitempty"`
+	Image  *viberImageRaw  `json:"image,omitempty"`
+}
+
+type viberCampaignParamsRaw struct {
+	TaskName        string              `json:"task_name,omitempty"`
+	SenderID        int                 `json:"sender_id"`
+	Recipients      []string            `json:"recipients,omitempty"`
+	AddressBook     int                 `json:"address_book,omitempty"`
+	Message         string              `json:"message"`
+	MessageType     int                 `json:"message_type,omitempty"`
+	MessageLiveTime int                 `json:"message_live_time,omitempty"`
+	SendDate        string              `json:"send_date"`
+	Additional      *viberAdditionalRaw `json:"additional,omitempty"`
+}
+
+type viberCampaignRaw struct {
+	ID          interface{} `json:"id"`
+	Name        string      `json:"name"`
+	SenderName  string      `json:"sender_name"`
+	Message     string      `json:"message"`
+	ButtonText  string      `json:"button_text"`
+	ButtonLink  string      `json:"button_link"`
+	ImageLink   string      `json:"image_link"`
+	AddressBook interface{} `json:"address_book"`
+	Status      interface{} `json:"status"`
+	SendDate    string      `json:"send_date"`
+	Created     string      `json:"created"`
+	Statistic   struct {
+		Sent        interface{} `json:"sent"`
+		Delivered   interface{} `json:"delivered"`
+		Read        interface{} `json:"read"`
+		Redirected  interface{} `json:"redirected"`
+		Undelivered interface{} `json:"undelivered"`
+	} `json:"statistic"`
+}
+
+func (raw viberCampaignRaw) campaign() ViberCampaign {
+	campaign := ViberCampaign{
+		ID:            toInt(raw.ID),
+		Name:          raw.Name,
+		SenderName:    raw.SenderName,
+		Message:       raw.Message,
+		ButtonText:    raw.ButtonText,
+		ButtonLink:    raw.ButtonLink,
+		ImageURL:      raw.ImageLink,
+		AddressBookID: toInt(raw.AddressBook),
+		Status:        toInt(raw.Status),
+	}
+	if sendDate, err := time.Parse(sendDateLayout, raw.SendDate); err == nil {
+		campaign.SendDate = sendDate
+	}
+	if created, err := time.Parse(sendDateLayout, raw.Created); err == nil {
+		campaign.Created = created
+	}
+	return campaign
+}
+
+func (s *viber) SendCampaign(params ViberCampaignParams) (*ViberResult, error) {
+	return s.SendCampaignContext(context.Background(), params)
+}
+
+func (s *viber) SendCampaignContext(ctx context.Context, params ViberCampaignParams) (*ViberResult, error) {
+	path := "/viber"
+
+	if len(params.Phones) == 0 && params.AddressBookID == 0 {
+		return nil, ErrNoRecipients
+	}
+
+	payload := viberCampaignParamsRaw{
+		TaskName:        params.Name,
+		SenderID:        params.SenderID,
+		Recipients:      params.Phones,
+		AddressBook:     params.AddressBookID,
+		Message:         params.Message,
+		MessageType:     params.MessageType,
+		MessageLiveTime: params.MessageLiveTime,
+		SendDate:        "now",
+	}
+	if params.SendDate != nil && !params.SendDate.IsZero() {
+		payload.SendDate = formatSendDate(*params.SendDate)
+	}
+	if params.ButtonText != "" || params.ButtonLink != "" || params.ImageURL != "" {
+		payload.Additional = &viberAdditionalRaw{}
+		if params.ButtonText != "" || params.ButtonLink != "" {
+			payload.Additional.Button = &viberButtonRaw{Text: params.ButtonText, Link: params.ButtonLink}
+		}
+		if params.ImageURL != "" {
+			payload.Additional.Image = &viberImageRaw{Link: params.ImageURL}
+		}
+	}
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "POST", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Client.checkResult("POST", path, body); err != nil {
+		return nil, err
+	}
+
+	var respData struct {
+		Data struct {
+			ID interface{} `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("POST", path, body, err.Error())
+	}
+
+	return &ViberResult{ID: toInt(respData.Data.ID)}, nil
+}
+
+func (s *viber) Campaigns() ([]ViberCampaign, error) {
+	return s.CampaignsContext(context.Background())
+}
+
+func (s *viber) CampaignsContext(ctx context.Context) ([]ViberCampaign, error) {
+	path := "/viber/task"
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	campaigns := make([]ViberCampaign, 0)
+	if isEmptyCollection(body) {
+		return campaigns, nil
+	}
+
+	var respData []viberCampaignRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range respData {
+		campaigns = append(campaigns, raw.campaign())
+	}
+	return campaigns, nil
+}
+
+func (s *viber) CampaignInfo(campaignID int) (*ViberCampaignDetail, error) {
+	return s.CampaignInfoContext(context.Background(), campaignID)
+}
+
+func (s *viber) CampaignInfoContext(ctx context.Context, campaignID int) (*ViberCampaignDetail, error) {
+	path := fmt.Sprintf("/viber/task/%d", campaignID)
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw viberCampaignRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	return &ViberCampaignDetail{
+		ViberCampaign: raw.campaign(),
+		Stats: ViberStats{
+			Sent:        toInt(raw.Statistic.Sent),
+			Delivered:   toInt(raw.Statistic.Delivered),
+			Read:        toInt(raw.Statistic.Read),
+			Clicked:     toInt(raw.Statistic.Redirected),
+			Undelivered: toInt(raw.Statistic.Undelivered),
+		},
+	}, nil
+}
+
+// CampaignStats returns the delivery statistics of the campaign, a shortcut for CampaignInfo
+func (s *viber) CampaignStats(campaignID int) (*ViberStats, error) {
+	return s.CampaignStatsContext(context.Background(), campaignID)
+}
+
+func (s *viber) CampaignStatsContext(ctx context.Context, campaignID int) (*ViberStats, error) {
+	detail, err := s.CampaignInfoContext(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	return &detail.Stats, nil
+}
+
+type ViberSender struct {
+	ID           int
+	Name         string
+	Status       string
+	AdminComment string
+}
+
+// IsApproved reports whether the sender passed moderation and can send campaigns
+func (s ViberSender) IsApproved() bool {
+	return strings.EqualFold(s.Status, "active")
+}
+
+type viberSenderRaw struct {
+	ID           interface{} `json:"id"`
+	Name         string      `json:"name"`
+	Status       string      `json:"status"`
+	AdminComment string      `json:"admin_comment"`
+}
+
+func (raw viberSenderRaw) sender() ViberSender {
+	return ViberSender{
+		ID:           toInt(raw.ID),
+		Name:         raw.Name,
+		Status:       raw.Status,
+		AdminComment: raw.AdminComment,
+	}
+}
+
+func (s *viber) Senders() ([]ViberSender, error) {
+	return s.SendersContext(context.Background())
+}
+
+func (s *viber) SendersContext(ctx context.Context) ([]ViberSender, error) {
+	path := "/viber/senders"
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	senders := make([]ViberSender, 0)
+	if isEmptyCollection(body) {
+		return senders, nil
+	}
+
+	var respData []viberSenderRaw
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	for _, raw := range respData {
+		senders = append(senders, raw.sender())
+	}
+	return senders, nil
+}
+
+func (s *viber) SenderInfo(senderID int) (*ViberSender, error) {
+	return s.SenderInfoContext(context.Background(), senderID)
+}
+
+func (s *viber) SenderInfoContext(ctx context.Context, senderID int) (*ViberSender, error) {
+	path := fmt.Sprintf("/viber/senders/%d", senderID)
+
+	body, err := s.Client.makeRequest(ctx, path, "GET", nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw viberSenderRaw
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, s.Client.invalidResponse("GET", path, body, err.Error())
+	}
+
+	sender := raw.sender()
+	return &sender, nil
+}
@@ -0,0 +1,55 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestViber_CampaignInfo_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/viber/task/2725",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 2725, "name": "Spring sale", "status": 3, "statistic": {"sent": 100, "delivered": "95", "read": 60, "redirected": 12, "undelivered": 5}}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	detail, err := spClient.Viber.CampaignInfo(2725)
+	assert.NoError(t, err)
+	assert.Equal(t, 2725, detail.ID)
+	assert.Equal(t, ViberStats{Sent: 100, Delivered: 95, Read: 60, Clicked: 12, Undelivered: 5}, detail.Stats)
+
+	stats, err := spClient.Viber.CampaignStats(2725)
+	assert.NoError(t, err)
+	assert.Equal(t, detail.Stats, *stats)
+}
+
+func TestViber_CampaignInfo_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/viber/task/1",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"error_code": 404, "message": "Not found"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	stats, err := spClient.Viber.CampaignStats(1)
+	assert.Nil(t, stats)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
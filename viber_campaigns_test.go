@@ -0,0 +1,61 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestViber_Campaigns_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/viber/task",
+		httpmock.NewStringResponder(http.StatusOK, `[{"id": 2725, "name": "Spring sale", "sender_name": "Shop", "message": "-20%", "button_text": "Shop now", "button_link": "https://example.com/sale", "image_link": "", "address_book": "0", "status": 3, "send_date": "2030-01-02 07:30:00", "created": "2030-01-02 07:29:00"}]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	campaigns, err := spClient.Viber.Campaigns()
+	assert.NoError(t, err)
+	assert.Equal(t, []ViberCampaign{{
+		ID:         2725,
+		Name:       "Spring sale",
+		SenderName: "Shop",
+		Message:    "-20%",
+		ButtonText: "Shop now",
+		ButtonLink: "https://example.com/sale",
+		Status:     3,
+		SendDate:   time.Date(2030, 1, 2, 7, 30, 0, 0, time.UTC),
+		Created:    time.Date(2030, 1, 2, 7, 29, 0, 0, time.UTC),
+	}}, campaigns)
+}
+
+func TestViber_Campaigns_BadJson(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/viber/task",
+		httpmock.NewStringResponder(http.StatusOK, `Invalid json`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	campaigns, err := spClient.Viber.Campaigns()
+	assert.Nil(t, campaigns)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
@@ -0,0 +1,87 @@
+package sendpulse
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// ViberMessageOption adds an optional part to a Viber message sent by Viber.SendMessage
+type ViberMessageOption func(*viberMessageRaw)
+
+// WithViberButton adds a button opening the link to the message
+func WithViberButton(text string, link string) ViberMessageOption {
+	return func(message *viberMessageRaw) {
+		if message.Additional == nil {
+			message.Additional = &viberAdditionalRaw{}
+		}
+		message.Additional.Button = &viberButtonRaw{Text: text, Link: link}
+	}
+}
+
+// WithViberImage adds the image at the URL to the message
+func WithViberImage(imageURL string) ViberMessageOption {
+	return func(message *viberMessageRaw) {
+		if message.Additional == nil {
+			message.Additional = &viberAdditionalRaw{}
+		}
+		message.Additional.Image = &viberImageRaw{Link: imageURL}
+	}
+}
+
+type viberMessageRaw struct {
+	SenderID   int                 `json:"sender_id"`
+	Phone      string              `json:"phone"`
+	Message    string              `json:"message"`
+	Additional *viberAdditionalRaw `json:"additional,omitempty"`
+}
+
+// SendMessage sends a transactional (service) Viber message, e.g. a one-time password, to a single phone.
+// The phone must be in international format: 10 to 15 digits with the country code and an optional leading +
+func (s *viber) SendMessage(senderID int, phone string, message string, opts ...ViberMessageOption) (*ViberResult, error) {
+	return s.SendMessageContext(context.Background(), senderID, phone, message, opts...)
+}
+
+func (s *viber) SendMessageContext(ctx context.Context, senderID int, phone string, message string, opts ...ViberMessageOption) (*ViberResult, error) {
+	path := "/viber/messages"
+
+	if !isInternationalPhone(phone) {
+		return nil, &InvalidPhonesError{Phones: []string{phone}}
+	}
+
+	payload := viberMessageRaw{
+		SenderID: senderID,
+		Phone:    strings.TrimPrefix(phone, "+"),
+		Message:  message,
+	}
+	for _, opt := range opts {
+		opt(&payload)
+	}
+
+	body, err := s.Client.makeJSONRequest(ctx, path, "POST", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Client.checkResult("POST", path, body); err != nil {
+		return nil, err
+	}
+
+	var respData struct {
+		Data struct {
+			ID     interface{} `json:"id"`
+			Status interface{} `json:"status"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &respData); err != nil {
+		return nil, s.Client.invalidResponse("POST", path, body, err.Error())
+	}
+
+	return &ViberResult{ID: toInt(respData.Data.ID), Status: toString(respData.Data.Status)}, nil
+}
+
+// isInternationalPhone checks the phone is a number with the country code: 10 to 15 digits not starting with 0
+func isInternationalPhone(phone string) bool {
+	digits := strings.TrimPrefix(phone, "+")
+	return isPhone(phone) && len(digits) >= 10 && len(digits) <= 15 && digits[0] != '0'
+}
@@ -0,0 +1,96 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestViber_SendCampaign_ImageAndButton(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/viber",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "data": {"id": 2725}}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.Viber.SendCampaign(ViberCampaignParams{
+		Name:       "Spring sale",
+		SenderID:   17,
+		Phones:     []string{"380501234567"},
+		Message:    "-20% on everything",
+		ButtonText: "Shop now",
+		ButtonLink: "https://example.com/sale",
+		ImageURL:   "https://example.com/sale.png",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2725, result.ID)
+	assert.JSONEq(t, `{
+		"task_name": "Spring sale",
+		"sender_id": 17,
+		"recipients": ["380501234567"],
+		"message": "-20% on everything",
+		"send_date": "now",
+		"additional": {
+			"button": {"text": "Shop now", "link": "https://example.com/sale"},
+			"image": {"link": "https://example.com/sale.png"}
+		}
+	}`, requestBody)
+}
+
+func TestViber_SendCampaign_TextOnly(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/viber",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "data": {"id": 2726}}`), nil
+		})
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	_, err := spClient.Viber.SendCampaign(ViberCampaignParams{
+		SenderID:      17,
+		AddressBookID: 12345,
+		Message:       "Hello",
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"sender_id": 17, "address_book": 12345, "message": "Hello", "send_date": "now"}`, requestBody)
+}
+
+func TestViber_SendCampaign_NoRecipients(t *testing.T) {
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+
+	result, err := spClient.Viber.SendCampaign(ViberCampaignParams{SenderID: 17, Message: "Hello"})
+	assert.Nil(t, result)
+	assert.Equal(t, ErrNoRecipients, err)
+}
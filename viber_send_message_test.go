@@ -0,0 +1,76 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestViber_SendMessage(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/viber/messages",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "data": {"id": 90121, "status": "sent"}}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.Viber.SendMessage(17, "+380501234567", "Your code is 4821")
+	assert.NoError(t, err)
+	assert.Equal(t, &ViberResult{ID: 90121, Status: "sent"}, result)
+	assert.JSONEq(t, `{"sender_id": 17, "phone": "380501234567", "message": "Your code is 4821"}`, requestBody)
+}
+
+func TestViber_SendMessage_Button(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var requestBody string
+	httpmock.RegisterResponder("POST", apiBaseUrl+"/viber/messages",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requestBody = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, `{"result": true, "data": {"id": "90122"}}`), nil
+		})
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	result, err := spClient.Viber.SendMessage(17, "380501234567", "Your order has shipped",
+		WithViberButton("Track", "https://example.com/track/1"),
+		WithViberImage("https://example.com/box.png"))
+	assert.NoError(t, err)
+	assert.Equal(t, &ViberResult{ID: 90122}, result)
+	assert.JSONEq(t, `{
+		"sender_id": 17,
+		"phone": "380501234567",
+		"message": "Your order has shipped",
+		"additional": {
+			"button": {"text": "Track", "link": "https://example.com/track/1"},
+			"image": {"link": "https://example.com/box.png"}
+		}
+	}`, requestBody)
+}
+
+func TestViber_SendMessage_InvalidPhone(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	spClient, _ := ApiClient(Config{UserID: fake.CharactersN(50), Secret: fake.CharactersN(50), Timeout: 5})
+	spClient.client.token = fake.Word()
+
+	for _, phone := range []string{"", "0501234567", "12345", "+38 050 123 45 67", "3805012345671234"} {
+		_, err := spClient.Viber.SendMessage(17, phone, "Your code is 4821")
+		assert.Equal(t, &InvalidPhonesError{Phones: []string{phone}}, err, phone)
+	}
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
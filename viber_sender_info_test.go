@@ -0,0 +1,50 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestViber_SenderInfo_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/viber/senders/17",
+		httpmock.NewStringResponder(http.StatusOK, `{"id": 17, "name": "Shop", "status": "active"}`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	sender, err := spClient.Viber.SenderInfo(17)
+	assert.NoError(t, err)
+	assert.Equal(t, ViberSender{ID: 17, Name: "Shop", Status: "active"}, *sender)
+}
+
+func TestViber_SenderInfo_BadJson(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/viber/senders/17",
+		httpmock.NewStringResponder(http.StatusOK, `Invalid json`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	sender, err := spClient.Viber.SenderInfo(17)
+	assert.Nil(t, sender)
+	_, isResponseError := err.(*SendpulseError)
+	assert.True(t, isResponseError)
+}
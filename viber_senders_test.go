@@ -0,0 +1,55 @@
+package sendpulse
+
+import (
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestViber_Senders_Success(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/viber/senders",
+		httpmock.NewStringResponder(http.StatusOK, `[{"id": 17, "name": "Shop", "status": "active", "admin_comment": ""}, {"id": "18", "name": "News", "status": "moderation", "admin_comment": "Logo is missing"}]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	senders, err := spClient.Viber.Senders()
+	assert.NoError(t, err)
+	assert.Equal(t, []ViberSender{
+		{ID: 17, Name: "Shop", Status: "active"},
+		{ID: 18, Name: "News", Status: "moderation", AdminComment: "Logo is missing"},
+	}, senders)
+	assert.True(t, senders[0].IsApproved())
+	assert.False(t, senders[1].IsApproved())
+}
+
+func TestViber_Senders_Empty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", apiBaseUrl+"/viber/senders",
+		httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+	config := Config{
+		UserID:  fake.CharactersN(50),
+		Secret:  fake.CharactersN(50),
+		Timeout: 5,
+	}
+	spClient, _ := ApiClient(config)
+	spClient.client.token = fake.Word()
+
+	senders, err := spClient.Viber.Senders()
+	assert.NoError(t, err)
+	assert.NotNil(t, senders)
+	assert.Empty(t, senders)
+}
@@ -0,0 +1,92 @@
+package sendpulse
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// WebhookEventType is the kind of the event SendPulse posts to a webhook
+type WebhookEventType string
+
+const (
+	WebhookEventDelivery    WebhookEventType = "delivery"
+	WebhookEventOpen        WebhookEventType = "open"
+	WebhookEventClick       WebhookEventType = "click"
+	WebhookEventUnsubscribe WebhookEventType = "unsubscribe"
+	WebhookEventSpam        WebhookEventType = "spam"
+	WebhookEventHardBounce  WebhookEventType = "hard_bounce"
+	WebhookEventSoftBounce  WebhookEventType = "soft_bounce"
+)
+
+// IsKnown reports whether the SDK knows the event type. Events of new types are still decoded, their data is kept in Raw
+func (t WebhookEventType) IsKnown() bool {
+	switch t {
+	case WebhookEventDelivery, WebhookEventOpen, WebhookEventClick, WebhookEventUnsubscribe,
+		WebhookEventSpam, WebhookEventHardBounce, WebhookEventSoftBounce:
+		return true
+	}
+	return false
+}
+
+// WebhookEvent is an event SendPulse posts to a webhook. Url is set for clicks only.
+// Raw holds every field of the event including the ones without a typed counterpart
+type WebhookEvent struct {
+	Event      WebhookEventType
+	Email      string
+	Timestamp  time.Time
+	CampaignID int
+	BookID     int
+	Url        string
+	Raw        map[string]interface{}
+}
+
+// ParseWebhookEvents decodes the body of a webhook request. It doesn't call the API
+func ParseWebhookEvents(body []byte) ([]WebhookEvent, error) {
+	var rawEvents []map[string]interface{}
+	if err := json.Unmarshal(body, &rawEvents); err != nil {
+		var rawEvent map[string]interface{}
+		if json.Unmarshal(body, &rawEvent) != nil {
+			return nil, err
+		}
+		rawEvents = []map[string]interface{}{rawEvent}
+	}
+
+	events := make([]WebhookEvent, 0, len(rawEvents))
+	for _, raw := range rawEvents {
+		events = append(events, WebhookEvent{
+			Event:      WebhookEventType(toString(raw["event"])),
+			Email:      toString(raw["email"]),
+			Timestamp:  webhookTimestamp(raw["timestamp"]),
+			CampaignID: toInt(firstValue(raw, "campaign_id", "task_id")),
+			BookID:     toInt(raw["book_id"]),
+			Url:        toString(firstValue(raw, "url", "link_url", "link")),
+			Raw:        raw,
+		})
+	}
+	return events, nil
+}
+
+// webhookTimestamp converts a unix timestamp (a number or a numeric string) or a "2006-01-02 15:04:05" date to time.Time
+func webhookTimestamp(value interface{}) time.Time {
+	timestamp := toString(value)
+	if timestamp == "" {
+		return time.Time{}
+	}
+	if seconds, err := strconv.ParseInt(timestamp, 10, 64); err == nil {
+		return time.Unix(seconds, 0).UTC()
+	}
+	if date, err := time.Parse(sendDateLayout, timestamp); err == nil {
+		return date
+	}
+	return time.Time{}
+}
+
+func firstValue(raw map[string]interface{}, keys ...string) interface{} {
+	for _, key := range keys {
+		if value, exists := raw[key]; exists && value != nil {
+			return value
+		}
+	}
+	return nil
+}
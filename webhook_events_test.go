@@ -0,0 +1,57 @@
+package sendpulse
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseWebhookEvents_Success(t *testing.T) {
+	body := []byte(`[
+		{"event": "open", "email": "john@example.com", "timestamp": 1620000000, "task_id": 3051, "book_id": 12},
+		{"event": "click", "email": "john@example.com", "timestamp": "1620000060", "campaign_id": "3051", "link_url": "https://example.com/sale"},
+		{"event": "hard_bounce", "email": "old@example.com", "timestamp": "2021-05-03 00:00:00", "task_id": 3051}
+	]`)
+
+	events, err := ParseWebhookEvents(body)
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+
+	assert.Equal(t, WebhookEventOpen, events[0].Event)
+	assert.Equal(t, "john@example.com", events[0].Email)
+	assert.Equal(t, time.Unix(1620000000, 0).UTC(), events[0].Timestamp)
+	assert.Equal(t, 3051, events[0].CampaignID)
+	assert.Equal(t, 12, events[0].BookID)
+	assert.Equal(t, "", events[0].Url)
+
+	assert.Equal(t, WebhookEventClick, events[1].Event)
+	assert.Equal(t, time.Unix(1620000060, 0).UTC(), events[1].Timestamp)
+	assert.Equal(t, 3051, events[1].CampaignID)
+	assert.Equal(t, "https://example.com/sale", events[1].Url)
+
+	assert.Equal(t, WebhookEventHardBounce, events[2].Event)
+	assert.Equal(t, time.Date(2021, 5, 3, 0, 0, 0, 0, time.UTC), events[2].Timestamp)
+}
+
+func TestParseWebhookEvents_UnknownEvent(t *testing.T) {
+	body := []byte(`[{"event": "resubscribe", "email": "john@example.com", "timestamp": 1620000000, "source": "form"}]`)
+
+	events, err := ParseWebhookEvents(body)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, WebhookEventType("resubscribe"), events[0].Event)
+	assert.False(t, events[0].Event.IsKnown())
+	assert.Equal(t, "form", events[0].Raw["source"])
+}
+
+func TestParseWebhookEvents_SingleObject(t *testing.T) {
+	events, err := ParseWebhookEvents([]byte(`{"event": "spam", "email": "john@example.com"}`))
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.True(t, events[0].Event.IsKnown())
+}
+
+func TestParseWebhookEvents_InvalidJSON(t *testing.T) {
+	_, err := ParseWebhookEvents([]byte(`not json`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,35 @@
+package sendpulse
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidWebhookSignature is returned by ParseSignedWebhookEvents when the signature doesn't match the body
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+// VerifyWebhookSignature reports whether the signature header of a webhook request is the hex-encoded
+// HMAC-SHA256 of the body with the webhook secret. A "sha256=" prefix of the header is allowed.
+// The comparison takes constant time. It doesn't call the API
+func VerifyWebhookSignature(secret string, header string, body []byte) bool {
+	signature, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(header), "sha256="))
+	if err != nil || len(signature) == 0 {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(signature, mac.Sum(nil))
+}
+
+// ParseSignedWebhookEvents verifies the signature of a webhook request with VerifyWebhookSignature
+// and decodes its body with ParseWebhookEvents
+func ParseSignedWebhookEvents(secret string, header string, body []byte) ([]WebhookEvent, error) {
+	if !VerifyWebhookSignature(secret, header, body) {
+		return nil, ErrInvalidWebhookSignature
+	}
+	return ParseWebhookEvents(body)
+}
@@ -0,0 +1,36 @@
+package sendpulse
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const (
+	testWebhookSecret    = "whsec_test"
+	testWebhookBody      = `[{"event":"open","email":"john@example.com","timestamp":1600000000}]`
+	testWebhookSignature = "8598d1c6cb26acf36e4dffd5197f77b1fa59cc27c0876262a1cf50927530ab21"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	body := []byte(testWebhookBody)
+
+	assert.True(t, VerifyWebhookSignature(testWebhookSecret, testWebhookSignature, body))
+	assert.True(t, VerifyWebhookSignature(testWebhookSecret, "sha256="+strings.ToUpper(testWebhookSignature), body))
+
+	assert.False(t, VerifyWebhookSignature(testWebhookSecret, testWebhookSignature, []byte(strings.Replace(testWebhookBody, "open", "click", 1))))
+	assert.False(t, VerifyWebhookSignature("other_secret", testWebhookSignature, body))
+	assert.False(t, VerifyWebhookSignature(testWebhookSecret, "", body))
+	assert.False(t, VerifyWebhookSignature(testWebhookSecret, "not hex", body))
+}
+
+func TestParseSignedWebhookEvents(t *testing.T) {
+	events, err := ParseSignedWebhookEvents(testWebhookSecret, testWebhookSignature, []byte(testWebhookBody))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, WebhookEventOpen, events[0].Event)
+	assert.Equal(t, "john@example.com", events[0].Email)
+
+	_, err = ParseSignedWebhookEvents(testWebhookSecret, testWebhookSignature, []byte(`[{"event":"spam"}]`))
+	assert.Equal(t, ErrInvalidWebhookSignature, err)
+}